@@ -39,6 +39,7 @@ const (
 	MimetypeDataWithValidator = "data/validator"
 	MimetypeTypedData         = "data/typed"
 	MimetypeClique            = "application/x-clique-header"
+	MimetypeCliqueAttestation = "application/x-clique-attestation"
 	MimetypeTextPlain         = "text/plain"
 )
 
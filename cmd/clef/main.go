@@ -759,7 +759,7 @@ func signer(c *cli.Context) error {
 	if !c.Bool(utils.IPCDisabledFlag.Name) {
 		givenPath := c.String(utils.IPCPathFlag.Name)
 		ipcapiURL = ipcEndpoint(filepath.Join(givenPath, "clef.ipc"), configDir)
-		listener, _, err := rpc.StartIPCEndpoint(ipcapiURL, rpcAPI)
+		listener, _, err := rpc.StartIPCEndpoint(ipcapiURL, rpcAPI, rpc.Limits{}, nil)
 		if err != nil {
 			utils.Fatalf("Could not start IPC api: %v", err)
 		}
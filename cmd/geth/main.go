@@ -85,12 +85,16 @@ var (
 		utils.TxPoolAccountQueueFlag,
 		utils.TxPoolGlobalQueueFlag,
 		utils.TxPoolLifetimeFlag,
+		utils.TxPoolPrivateLifetimeFlag,
+		utils.TxPoolPolicyFlag,
 		utils.SyncModeFlag,
 		utils.SyncTargetFlag,
 		utils.ExitWhenSyncedFlag,
 		utils.GCModeFlag,
 		utils.SnapshotFlag,
 		utils.TxLookupLimitFlag,
+		utils.LogIndexFlag,
+		utils.LogIndexHistoryFlag,
 		utils.LightServeFlag,
 		utils.LightIngressFlag,
 		utils.LightEgressFlag,
@@ -129,6 +133,7 @@ var (
 		utils.MinerRecommitIntervalFlag,
 		utils.MinerNoVerifyFlag,
 		utils.MinerNewPayloadTimeout,
+		utils.CliqueAttestationsFlag,
 		utils.NATFlag,
 		utils.NoDiscoverFlag,
 		utils.DiscoveryV5Flag,
@@ -179,6 +184,15 @@ var (
 		utils.RPCGlobalGasCapFlag,
 		utils.RPCGlobalEVMTimeoutFlag,
 		utils.RPCGlobalTxFeeCapFlag,
+		utils.RPCLogRangeFlag,
+		utils.RPCLogResultsFlag,
+		utils.RPCBatchLimitFlag,
+		utils.RPCResponseLimitFlag,
+		utils.RPCMethodLimitsFlag,
+		utils.RPCAPIKeysFlag,
+		utils.RPCAccessLogFlag,
+		utils.RPCSlowLogFlag,
+		utils.RPCSlowThresholdFlag,
 		utils.AllowUnprotectedTxs,
 	}
 
@@ -239,6 +253,8 @@ func init() {
 		snapshotCommand,
 		// See verkle.go
 		verkleCommand,
+		// See migrationcmd.go
+		migrationsCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
@@ -0,0 +1,387 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/state/migrations"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/internal/flags"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/olekukonko/tablewriter"
+	cli "github.com/urfave/cli/v2"
+)
+
+var (
+	migrationsCommand = &cli.Command{
+		Name:        "migrations",
+		Usage:       "A set of commands for inspecting state migrations",
+		Description: "",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "list",
+				Usage:  "List state migrations registered for the chain",
+				Action: listMigrations,
+				Flags:  flags.Merge(utils.NetworkFlags, utils.DatabasePathFlags),
+				Description: `
+geth migrations list
+will print name, block and source of all state migrations registered
+for the active chain config, both built-in and declared in chain config.
+`,
+			},
+			{
+				Name:      "plan",
+				Usage:     "Dry-run state migrations scheduled at the specified block",
+				ArgsUsage: "<block>",
+				Action:    planMigrations,
+				Flags:     flags.Merge(utils.NetworkFlags, utils.DatabasePathFlags),
+				Description: `
+geth migrations plan <block>
+will execute state migrations scheduled at the specified block against
+its parent state and print the per-account diff without persisting it.
+If the block is not imported yet, the head state is used instead.
+`,
+			},
+			{
+				Name:      "verify",
+				Usage:     "Re-execute migration blocks and compare resulting state roots",
+				ArgsUsage: "[<block>]",
+				Action:    verifyMigrations,
+				Flags:     flags.Merge(utils.NetworkFlags, utils.DatabasePathFlags),
+				Description: `
+geth migrations verify [<block>]
+will re-execute the specified block or all imported blocks with scheduled
+state migrations and ensure the resulting state root matches the stored header.
+The parent state of each block must be available in the database.
+`,
+			},
+			{
+				Name:   "burntxs",
+				Usage:  "Generate a state migration marking burn txs minted before the unique burn tx fork as used",
+				Action: burnTxsMigration,
+				Flags:  flags.Merge(utils.NetworkFlags, utils.DatabasePathFlags),
+				Description: `
+geth migrations burntxs
+will collect burn txs minted by canonical blocks before the unique burn tx
+fork from the mint index and print a state migration marking them as used.
+Only mints executed since the fork are tracked in the mint contract state,
+so the printed migration has to be added to the "stateMigrations" list of
+the chain config before the fork block is reached. The chain must be synced
+up to the block preceding the fork.
+`,
+			},
+		},
+	}
+)
+
+// migrationSource returns a human-readable origin of the migration.
+func migrationSource(migration state.Migration) string {
+	if _, ok := migration.(*migrations.ConfigMigration); ok {
+		return "chain config"
+	}
+	return "built-in"
+}
+
+// migrationBlocks returns heights of all scheduled migrations in ascending order.
+func migrationBlocks(list state.Migrations) []uint64 {
+	blocks := make([]uint64, 0, len(list))
+	for block := range list {
+		blocks = append(blocks, block)
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i] < blocks[j] })
+	return blocks
+}
+
+func listMigrations(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, _ := utils.MakeChain(ctx, stack, true)
+	defer chain.Stop()
+
+	var (
+		list = state.InitMigrations(chain.Config())
+		head = chain.CurrentBlock().Number.Uint64()
+		data [][]string
+	)
+	for _, block := range migrationBlocks(list) {
+		status := "pending"
+		if block <= head {
+			status = "applied"
+		}
+		for _, migration := range list[block] {
+			data = append(data, []string{strconv.FormatUint(block, 10), migration.Name(), migrationSource(migration), status})
+		}
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Block", "Name", "Source", "Status"})
+	table.AppendBulk(data)
+	table.Render()
+	return nil
+}
+
+func planMigrations(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("block number is required")
+	}
+	number, err := strconv.ParseUint(ctx.Args().First(), 0, 64)
+	if err != nil || number == 0 {
+		return fmt.Errorf("invalid block number %q", ctx.Args().First())
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, _ := utils.MakeChain(ctx, stack, true)
+	defer chain.Stop()
+
+	list := state.InitMigrations(chain.Config())[number]
+	if len(list) == 0 {
+		return fmt.Errorf("no state migrations scheduled at block %d", number)
+	}
+	parent := chain.GetHeaderByNumber(number - 1)
+	if parent == nil {
+		parent = chain.CurrentBlock()
+		log.Warn("Parent block is not imported yet, using head state", "number", parent.Number, "hash", parent.Hash())
+	}
+	statedb, err := chain.StateAt(parent.Root)
+	if err != nil {
+		return fmt.Errorf("state of block %d is not available: %v", parent.Number, err)
+	}
+	for _, migration := range list {
+		recorder := newMigrationRecorder(statedb)
+		migration.Execute(recorder)
+
+		fmt.Printf("Migration %q (%s) at block %d\n", migration.Name(), migrationSource(migration), number)
+		recorder.print()
+		fmt.Println()
+	}
+	return nil
+}
+
+func verifyMigrations(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return errors.New("too many arguments")
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, _ := utils.MakeChain(ctx, stack, true)
+	defer chain.Stop()
+
+	var (
+		list   = state.InitMigrations(chain.Config())
+		head   = chain.CurrentBlock().Number.Uint64()
+		blocks []uint64
+	)
+	if ctx.NArg() == 1 {
+		number, err := strconv.ParseUint(ctx.Args().First(), 0, 64)
+		if err != nil {
+			return fmt.Errorf("invalid block number %q", ctx.Args().First())
+		}
+		if len(list[number]) == 0 {
+			return fmt.Errorf("no state migrations scheduled at block %d", number)
+		}
+		blocks = []uint64{number}
+	} else {
+		for _, number := range migrationBlocks(list) {
+			if number <= head {
+				blocks = append(blocks, number)
+			}
+		}
+	}
+	var failed int
+	for _, number := range blocks {
+		if err := verifyMigrationBlock(chain, number); err != nil {
+			log.Error("State migration verification failed", "number", number, "err", err)
+			failed++
+			continue
+		}
+		log.Info("State migration verified", "number", number)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d migration blocks failed verification", failed, len(blocks))
+	}
+	log.Info("All migration blocks verified", "count", len(blocks))
+	return nil
+}
+
+func burnTxsMigration(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, db := utils.MakeChain(ctx, stack, true)
+	defer chain.Stop()
+
+	migration, err := core.UsedBurnTxsMigration(db, chain.Config())
+	if err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(migration, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// verifyMigrationBlock re-executes the block on top of its parent state and
+// compares the resulting state root with the one stored in the header.
+func verifyMigrationBlock(chain *core.BlockChain, number uint64) error {
+	if number == 0 {
+		return errors.New("genesis block cannot be re-executed")
+	}
+	block := chain.GetBlockByNumber(number)
+	if block == nil {
+		return errors.New("block is not imported")
+	}
+	parent := chain.GetHeader(block.ParentHash(), number-1)
+	if parent == nil {
+		return errors.New("parent block is not found")
+	}
+	statedb, err := chain.StateAt(parent.Root)
+	if err != nil {
+		return fmt.Errorf("parent state is not available: %v", err)
+	}
+	if _, _, _, err := chain.Processor().Process(block, statedb, vm.Config{}); err != nil {
+		return err
+	}
+	if root := statedb.IntermediateRoot(chain.Config().IsEIP158(block.Number())); root != block.Root() {
+		return fmt.Errorf("state root mismatch: have %x, want %x", root, block.Root())
+	}
+	return nil
+}
+
+// accountOrigin holds account fields before the first modification by a migration.
+type accountOrigin struct {
+	balance  *big.Int
+	nonce    uint64
+	codeHash common.Hash
+	storage  map[common.Hash]common.Hash
+	slots    []common.Hash // Modified storage slots in order of modification
+}
+
+// migrationRecorder wraps a state database and records original values of
+// everything modified through it, so the changes can be reported as a diff.
+type migrationRecorder struct {
+	*state.StateDB
+
+	accounts  map[common.Address]*accountOrigin
+	addresses []common.Address // Modified accounts in order of modification
+}
+
+func newMigrationRecorder(statedb *state.StateDB) *migrationRecorder {
+	return &migrationRecorder{
+		StateDB:  statedb,
+		accounts: make(map[common.Address]*accountOrigin),
+	}
+}
+
+func (r *migrationRecorder) touch(addr common.Address) *accountOrigin {
+	if origin, ok := r.accounts[addr]; ok {
+		return origin
+	}
+	origin := &accountOrigin{
+		balance:  r.StateDB.GetBalance(addr),
+		nonce:    r.StateDB.GetNonce(addr),
+		codeHash: r.StateDB.GetCodeHash(addr),
+		storage:  make(map[common.Hash]common.Hash),
+	}
+	r.accounts[addr] = origin
+	r.addresses = append(r.addresses, addr)
+	return origin
+}
+
+func (r *migrationRecorder) CreateAccount(addr common.Address) {
+	r.touch(addr)
+	r.StateDB.CreateAccount(addr)
+}
+
+func (r *migrationRecorder) AddBalance(addr common.Address, amount *big.Int) {
+	r.touch(addr)
+	r.StateDB.AddBalance(addr, amount)
+}
+
+func (r *migrationRecorder) SubBalance(addr common.Address, amount *big.Int) {
+	r.touch(addr)
+	r.StateDB.SubBalance(addr, amount)
+}
+
+func (r *migrationRecorder) SetNonce(addr common.Address, nonce uint64) {
+	r.touch(addr)
+	r.StateDB.SetNonce(addr, nonce)
+}
+
+func (r *migrationRecorder) SetCode(addr common.Address, code []byte) {
+	r.touch(addr)
+	r.StateDB.SetCode(addr, code)
+}
+
+func (r *migrationRecorder) SetState(addr common.Address, key, value common.Hash) {
+	origin := r.touch(addr)
+	if _, ok := origin.storage[key]; !ok {
+		origin.storage[key] = r.StateDB.GetState(addr, key)
+		origin.slots = append(origin.slots, key)
+	}
+	r.StateDB.SetState(addr, key, value)
+}
+
+// print writes the diff between recorded and current values to stdout.
+func (r *migrationRecorder) print() {
+	if len(r.addresses) == 0 {
+		fmt.Println("  no state changes")
+		return
+	}
+	for _, addr := range r.addresses {
+		origin := r.accounts[addr]
+		fmt.Printf("  account %s\n", addr.Hex())
+
+		if balance := r.StateDB.GetBalance(addr); balance.Cmp(origin.balance) != 0 {
+			fmt.Printf("    balance: %v -> %v\n", origin.balance, balance)
+		}
+		if nonce := r.StateDB.GetNonce(addr); nonce != origin.nonce {
+			fmt.Printf("    nonce:   %d -> %d\n", origin.nonce, nonce)
+		}
+		if codeHash := r.StateDB.GetCodeHash(addr); codeHash != origin.codeHash {
+			fmt.Printf("    code:    %s -> %s (%d bytes)\n", formatCodeHash(origin.codeHash), formatCodeHash(codeHash), r.StateDB.GetCodeSize(addr))
+		}
+		for _, key := range origin.slots {
+			if value := r.StateDB.GetState(addr, key); value != origin.storage[key] {
+				fmt.Printf("    storage %s: %s -> %s\n", key.Hex(), origin.storage[key].Hex(), value.Hex())
+			}
+		}
+	}
+}
+
+// formatCodeHash returns a code hash representation, distinguishing accounts without code.
+func formatCodeHash(hash common.Hash) string {
+	if hash == (common.Hash{}) || hash == types.EmptyCodeHash {
+		return "<none>"
+	}
+	return hash.Hex()
+}
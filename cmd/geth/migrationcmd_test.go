@@ -0,0 +1,84 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestMigrationRecorderAndVerify(t *testing.T) {
+	var (
+		target = common.HexToAddress("0x1000000000000000000000000000000000000000")
+		config = *params.TestChainConfig
+	)
+	config.CepheusBlock = big.NewInt(0)
+	config.StateMigrations = []*params.StateMigrationConfig{{
+		Name:  "fix",
+		Block: big.NewInt(2),
+		Accounts: map[common.Address]*params.StateMigrationAccount{
+			target: {
+				Code:    []byte{0x60, 0x01},
+				Storage: map[common.Hash]common.Hash{common.HexToHash("0x01"): common.HexToHash("0x02")},
+				Balance: (*math.HexOrDecimal256)(big.NewInt(100)),
+			},
+		},
+	}}
+	gspec := &core.Genesis{Config: &config, BaseFee: big.NewInt(params.InitialBaseFee)}
+	_, blocks, _ := core.GenerateChainWithGenesis(gspec, ethash.NewFaker(), 3, nil)
+
+	chain, err := core.NewBlockChain(rawdb.NewMemoryDatabase(), &core.CacheConfig{TrieDirtyDisabled: true}, gspec, nil, ethash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	if err := verifyMigrationBlock(chain, 2); err != nil {
+		t.Fatalf("failed to verify migration block: %v", err)
+	}
+	if err := verifyMigrationBlock(chain, 4); err == nil {
+		t.Fatal("expected verification of missing block to fail")
+	}
+
+	statedb, _ := chain.StateAt(blocks[0].Root())
+	recorder := newMigrationRecorder(statedb)
+	for _, migration := range state.InitMigrations(&config)[2] {
+		migration.Execute(recorder)
+	}
+	origin := recorder.accounts[target]
+	if len(recorder.addresses) != 1 || origin == nil {
+		t.Fatalf("unexpected modified accounts: %v", recorder.addresses)
+	}
+	if origin.balance.Sign() != 0 || recorder.GetBalance(target).Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("balance mismatch: have %v -> %v", origin.balance, recorder.GetBalance(target))
+	}
+	if have := origin.storage[common.HexToHash("0x01")]; have != (common.Hash{}) {
+		t.Errorf("storage origin mismatch: have %x", have)
+	}
+}
@@ -52,6 +52,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/eth/mintapi"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/ethdb/remotedb"
@@ -257,6 +258,17 @@ var (
 		Value:    ethconfig.Defaults.TxLookupLimit,
 		Category: flags.EthCategory,
 	}
+	LogIndexFlag = &cli.BoolFlag{
+		Name:     "logindex",
+		Usage:    "Maintains an exact address and topic index of logs for fast historical log queries",
+		Category: flags.EthCategory,
+	}
+	LogIndexHistoryFlag = &cli.Uint64Flag{
+		Name:     "logindex.history",
+		Usage:    "Number of recent sections of 4096 blocks to maintain the log index for (0 = entire chain)",
+		Value:    ethconfig.Defaults.LogIndexHistory,
+		Category: flags.EthCategory,
+	}
 	LightKDFFlag = &cli.BoolFlag{
 		Name:     "lightkdf",
 		Usage:    "Reduce key-derivation RAM & CPU usage at some expense of KDF strength",
@@ -448,6 +460,17 @@ var (
 		Value:    ethconfig.Defaults.TxPool.Lifetime,
 		Category: flags.TxPoolCategory,
 	}
+	TxPoolPrivateLifetimeFlag = &cli.Uint64Flag{
+		Name:     "txpool.privatelifetime",
+		Usage:    "Number of blocks private transactions and bundles are kept for unless a block number is given",
+		Value:    ethconfig.Defaults.TxPool.PrivateLifetime,
+		Category: flags.TxPoolCategory,
+	}
+	TxPoolPolicyFlag = &cli.StringFlag{
+		Name:     "txpool.policy",
+		Usage:    "JSON file with per-address transaction admission policy (reloadable with txpool_setPolicy)",
+		Category: flags.TxPoolCategory,
+	}
 
 	// Performance tuning settings
 	CacheFlag = &cli.IntFlag{
@@ -569,6 +592,11 @@ var (
 		Usage:    "Disable remote sealing verification",
 		Category: flags.MinerCategory,
 	}
+	CliqueAttestationsFlag = &cli.BoolFlag{
+		Name:     "clique.attestations",
+		Usage:    "Exchange clique signer attestations to finalize blocks signed off by more than half of the signers",
+		Category: flags.MinerCategory,
+	}
 	MinerNewPayloadTimeout = &cli.DurationFlag{
 		Name:     "miner.newpayload-timeout",
 		Usage:    "Specify the maximum time allowance for creating a new payload",
@@ -621,12 +649,60 @@ var (
 		Value:    ethconfig.Defaults.RPCEVMTimeout,
 		Category: flags.APICategory,
 	}
+	RPCLogRangeFlag = &cli.Uint64Flag{
+		Name:     "rpc.logrange",
+		Usage:    "Sets a cap on the number of blocks spanned by eth_getLogs and the block range of its pages (0=infinite)",
+		Value:    ethconfig.Defaults.FilterBlockRange,
+		Category: flags.APICategory,
+	}
+	RPCLogResultsFlag = &cli.IntFlag{
+		Name:     "rpc.logresults",
+		Usage:    "Sets a cap on the number of logs returned by eth_getLogs and the size of its pages (0=infinite)",
+		Value:    ethconfig.Defaults.FilterMaxResults,
+		Category: flags.APICategory,
+	}
 	RPCGlobalTxFeeCapFlag = &cli.Float64Flag{
 		Name:     "rpc.txfeecap",
 		Usage:    "Sets a cap on transaction fee (in ether) that can be sent via the RPC APIs (0 = no cap)",
 		Value:    ethconfig.Defaults.RPCTxFeeCap,
 		Category: flags.APICategory,
 	}
+	RPCBatchLimitFlag = &cli.IntFlag{
+		Name:     "rpc.batchlimit",
+		Usage:    "Maximum number of requests in a batch served over HTTP, WS and IPC (0=unlimited)",
+		Category: flags.APICategory,
+	}
+	RPCResponseLimitFlag = &cli.IntFlag{
+		Name:     "rpc.responselimit",
+		Usage:    "Maximum size in bytes of the results of a response or a batch served over HTTP, WS and IPC (0=unlimited)",
+		Category: flags.APICategory,
+	}
+	RPCMethodLimitsFlag = &cli.StringFlag{
+		Name:     "rpc.methodlimits",
+		Usage:    "Comma separated method=limit pairs capping the concurrent calls of the methods per endpoint (e.g. eth_getLogs=4)",
+		Category: flags.APICategory,
+	}
+	RPCAPIKeysFlag = &flags.DirectoryFlag{
+		Name:     "rpc.apikeys",
+		Usage:    "Path to a JSON file of API keys required by the HTTP-RPC and WS-RPC servers",
+		Category: flags.APICategory,
+	}
+	RPCAccessLogFlag = &flags.DirectoryFlag{
+		Name:     "rpc.accesslog",
+		Usage:    "Path to a JSON lines log of the calls served by the IPC, HTTP-RPC and WS-RPC servers",
+		Category: flags.APICategory,
+	}
+	RPCSlowLogFlag = &flags.DirectoryFlag{
+		Name:     "rpc.slowlog",
+		Usage:    "Path to a JSON lines log of the slow RPC calls, including their parameters",
+		Category: flags.APICategory,
+	}
+	RPCSlowThresholdFlag = &cli.DurationFlag{
+		Name:     "rpc.slowthreshold",
+		Usage:    "Minimum duration of the calls written to the slow query log",
+		Value:    node.DefaultConfig.RPCSlowThreshold,
+		Category: flags.APICategory,
+	}
 	// Authenticated RPC HTTP settings
 	AuthListenFlag = &cli.StringFlag{
 		Name:     "authrpc.addr",
@@ -1263,6 +1339,39 @@ func setWS(ctx *cli.Context, cfg *node.Config) {
 	}
 }
 
+// setRPCLimits configures the request limits of the HTTP, WS and IPC endpoints
+// from the set command line flags.
+func setRPCLimits(ctx *cli.Context, cfg *node.Config) {
+	limits := []*rpc.Limits{&cfg.HTTPLimits, &cfg.WSLimits, &cfg.IPCLimits}
+	if ctx.IsSet(RPCBatchLimitFlag.Name) {
+		for _, l := range limits {
+			l.BatchItems = ctx.Int(RPCBatchLimitFlag.Name)
+		}
+	}
+	if ctx.IsSet(RPCResponseLimitFlag.Name) {
+		for _, l := range limits {
+			l.ResponseBytes = ctx.Int(RPCResponseLimitFlag.Name)
+		}
+	}
+	if ctx.IsSet(RPCMethodLimitsFlag.Name) {
+		methods := make(map[string]int)
+		for _, entry := range SplitAndTrim(ctx.String(RPCMethodLimitsFlag.Name)) {
+			method, value, ok := strings.Cut(entry, "=")
+			if !ok {
+				Fatalf("Invalid --%s entry %q, want method=limit", RPCMethodLimitsFlag.Name, entry)
+			}
+			limit, err := strconv.Atoi(value)
+			if err != nil || limit < 0 {
+				Fatalf("Invalid --%s limit for %s: %q", RPCMethodLimitsFlag.Name, method, value)
+			}
+			methods[method] = limit
+		}
+		for _, l := range limits {
+			l.MethodConcurrency = methods
+		}
+	}
+}
+
 // setIPC creates an IPC path configuration from the set command line flags,
 // returning an empty string if IPC was explicitly disabled, or the set path.
 func setIPC(ctx *cli.Context, cfg *node.Config) {
@@ -1475,6 +1584,7 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	SetP2PConfig(ctx, &cfg.P2P)
 	setIPC(ctx, cfg)
 	setHTTP(ctx, cfg)
+	setRPCLimits(ctx, cfg)
 	setGraphQL(ctx, cfg)
 	setWS(ctx, cfg)
 	setNodeUserIdent(ctx, cfg)
@@ -1485,6 +1595,20 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 		cfg.JWTSecret = ctx.String(JWTSecretFlag.Name)
 	}
 
+	if ctx.IsSet(RPCAPIKeysFlag.Name) {
+		cfg.APIKeysFile = ctx.String(RPCAPIKeysFlag.Name)
+	}
+
+	if ctx.IsSet(RPCAccessLogFlag.Name) {
+		cfg.RPCAccessLog = ctx.String(RPCAccessLogFlag.Name)
+	}
+	if ctx.IsSet(RPCSlowLogFlag.Name) {
+		cfg.RPCSlowLog = ctx.String(RPCSlowLogFlag.Name)
+	}
+	if ctx.IsSet(RPCSlowThresholdFlag.Name) {
+		cfg.RPCSlowThreshold = ctx.Duration(RPCSlowThresholdFlag.Name)
+	}
+
 	if ctx.IsSet(EnablePersonal.Name) {
 		cfg.EnablePersonal = true
 	}
@@ -1617,6 +1741,15 @@ func setTxPool(ctx *cli.Context, cfg *txpool.Config) {
 	if ctx.IsSet(TxPoolLifetimeFlag.Name) {
 		cfg.Lifetime = ctx.Duration(TxPoolLifetimeFlag.Name)
 	}
+	if ctx.IsSet(TxPoolPrivateLifetimeFlag.Name) {
+		cfg.PrivateLifetime = ctx.Uint64(TxPoolPrivateLifetimeFlag.Name)
+	}
+	if ctx.IsSet(TxPoolPolicyFlag.Name) {
+		cfg.PolicyFile = ctx.String(TxPoolPolicyFlag.Name)
+		if _, err := txpool.LoadPolicy(cfg.PolicyFile); err != nil {
+			Fatalf("Invalid --%s: %v", TxPoolPolicyFlag.Name, err)
+		}
+	}
 }
 
 func setEthash(ctx *cli.Context, cfg *ethconfig.Config) {
@@ -1813,6 +1946,12 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.IsSet(TxLookupLimitFlag.Name) {
 		cfg.TxLookupLimit = ctx.Uint64(TxLookupLimitFlag.Name)
 	}
+	if ctx.IsSet(LogIndexFlag.Name) {
+		cfg.LogIndex = ctx.Bool(LogIndexFlag.Name)
+	}
+	if ctx.IsSet(LogIndexHistoryFlag.Name) {
+		cfg.LogIndexHistory = ctx.Uint64(LogIndexHistoryFlag.Name)
+	}
 	if ctx.IsSet(CacheFlag.Name) || ctx.IsSet(CacheTrieFlag.Name) {
 		cfg.TrieCleanCache = ctx.Int(CacheFlag.Name) * ctx.Int(CacheTrieFlag.Name) / 100
 	}
@@ -1843,6 +1982,9 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.IsSet(DocRootFlag.Name) {
 		cfg.DocRoot = ctx.String(DocRootFlag.Name)
 	}
+	if ctx.IsSet(CliqueAttestationsFlag.Name) {
+		cfg.CliqueAttestations = ctx.Bool(CliqueAttestationsFlag.Name)
+	}
 	if ctx.IsSet(VMEnableDebugFlag.Name) {
 		// TODO(fjl): force-enable this in --dev mode
 		cfg.EnablePreimageRecording = ctx.Bool(VMEnableDebugFlag.Name)
@@ -1862,6 +2004,12 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.IsSet(RPCGlobalTxFeeCapFlag.Name) {
 		cfg.RPCTxFeeCap = ctx.Float64(RPCGlobalTxFeeCapFlag.Name)
 	}
+	if ctx.IsSet(RPCLogRangeFlag.Name) {
+		cfg.FilterBlockRange = ctx.Uint64(RPCLogRangeFlag.Name)
+	}
+	if ctx.IsSet(RPCLogResultsFlag.Name) {
+		cfg.FilterMaxResults = ctx.Int(RPCLogResultsFlag.Name)
+	}
 	if ctx.IsSet(NoDiscoverFlag.Name) {
 		cfg.EthDiscoveryURLs, cfg.SnapDiscoveryURLs = []string{}, []string{}
 	} else if ctx.IsSet(DNSDiscoveryFlag.Name) {
@@ -2062,14 +2210,25 @@ func RegisterFilterAPI(stack *node.Node, backend ethapi.Backend, ethcfg *ethconf
 	isLightClient := ethcfg.SyncMode == downloader.LightSync
 	filterSystem := filters.NewFilterSystem(backend, filters.Config{
 		LogCacheSize: ethcfg.FilterLogCacheSize,
+		BlockRange:   ethcfg.FilterBlockRange,
+		MaxResults:   ethcfg.FilterMaxResults,
 	})
+	filterAPI := filters.NewFilterAPI(filterSystem, isLightClient)
 	stack.RegisterAPIs([]rpc.API{{
 		Namespace: "eth",
-		Service:   filters.NewFilterAPI(filterSystem, isLightClient),
+		Service:   filterAPI,
+	}, {
+		Namespace: "txpool",
+		Service:   filters.NewTxPoolEventsAPI(filterAPI),
 	}})
 	return filterSystem
 }
 
+// RegisterMintAPI adds the mint contract state and history RPC API to the node.
+func RegisterMintAPI(stack *node.Node, backend ethapi.Backend, filterSystem *filters.FilterSystem) {
+	stack.RegisterAPIs(mintapi.APIs(backend, filterSystem))
+}
+
 // RegisterFullSyncTester adds the full-sync tester service into node.
 func RegisterFullSyncTester(stack *node.Node, eth *eth.Ethereum, path string) {
 	blob, err := os.ReadFile(path)
@@ -26,6 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/trie"
@@ -445,6 +446,17 @@ func (beacon *Beacon) SetThreads(threads int) {
 	}
 }
 
+// IndexBlock updates the block index of the eth1 engine, if it keeps one, with
+// a pre-merge block being written to the chain.
+func (beacon *Beacon) IndexBlock(chain consensus.ChainHeaderReader, header *types.Header, batch ethdb.KeyValueWriter) {
+	type indexer interface {
+		IndexBlock(chain consensus.ChainHeaderReader, header *types.Header, batch ethdb.KeyValueWriter)
+	}
+	if ix, ok := beacon.ethone.(indexer); ok && !beacon.IsPoSHeader(header) {
+		ix.IndexBlock(chain, header, batch)
+	}
+}
+
 // IsTTDReached checks if the TotalTerminalDifficulty has been surpassed on the `parentHash` block.
 // It depends on the parentHash already being stored in the database.
 // If the parentHash is not stored in the database a UnknownAncestor error is returned.
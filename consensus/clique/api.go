@@ -99,28 +99,44 @@ func (api *API) Proposals() map[common.Address]bool {
 	defer api.clique.lock.RUnlock()
 
 	proposals := make(map[common.Address]bool)
-	for address, auth := range api.clique.proposals {
-		proposals[address] = auth
+	for address, proposal := range api.clique.proposals {
+		proposals[address] = proposal.Authorize
 	}
 	return proposals
 }
 
-// Propose injects a new authorization proposal that the signer will attempt to
-// push through.
-func (api *API) Propose(address common.Address, auth bool) {
-	api.clique.lock.Lock()
-	defer api.clique.lock.Unlock()
+// ProposalExpiry is the optional expiry of a proposal, after which the signer
+// stops voting on it.
+type ProposalExpiry struct {
+	Block *hexutil.Uint64 `json:"block"` // Last block to vote on the proposal in
+	Time  *hexutil.Uint64 `json:"time"`  // Unix time the proposal expires at
+}
 
-	api.clique.proposals[address] = auth
+// Propose injects a new authorization proposal that the signer will attempt to
+// push through. The proposal is persisted and survives restarts until it is
+// discarded, replaced or expired. The expiry is optional.
+func (api *API) Propose(address common.Address, auth bool, expiry *ProposalExpiry) {
+	var expiryBlock, expiryTime uint64
+	if expiry != nil && expiry.Block != nil {
+		expiryBlock = uint64(*expiry.Block)
+	}
+	if expiry != nil && expiry.Time != nil {
+		expiryTime = uint64(*expiry.Time)
+	}
+	api.clique.propose(address, auth, expiryBlock, expiryTime)
 }
 
 // Discard drops a currently running proposal, stopping the signer from casting
 // further votes (either for or against).
 func (api *API) Discard(address common.Address) {
-	api.clique.lock.Lock()
-	defer api.clique.lock.Unlock()
+	api.clique.discard(address)
+}
 
-	delete(api.clique.proposals, address)
+// GetProposalHistory returns the lifecycle of all proposals made on the node,
+// including the locally sealed canonical headers their votes were included in,
+// optionally filtered by the proposed address.
+func (api *API) GetProposalHistory(address *common.Address) ([]*Proposal, error) {
+	return api.clique.proposalHistory(address)
 }
 
 type status struct {
@@ -179,6 +195,25 @@ func (api *API) Status() (*status, error) {
 	}, nil
 }
 
+// GetSignerStats returns the sealing performance of signers over the specified
+// range of blocks: in-turn and out-of-turn seals, missed in-turn slots, the
+// longest gap between seals and the average seal delay beyond the period.
+// If the end of the range is not specified, the current head is used.
+func (api *API) GetSignerStats(from rpc.BlockNumber, to *rpc.BlockNumber) (*SignerStatsResult, error) {
+	head := api.chain.CurrentHeader().Number.Uint64()
+	resolve := func(number rpc.BlockNumber) uint64 {
+		if number < 0 {
+			return head
+		}
+		return uint64(number)
+	}
+	end := head
+	if to != nil {
+		end = resolve(*to)
+	}
+	return api.clique.signerStats(api.chain, resolve(from), end)
+}
+
 type blockNumberOrHashOrRLP struct {
 	*rpc.BlockNumberOrHash
 	RLP hexutil.Bytes `json:"rlp,omitempty"`
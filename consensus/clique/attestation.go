@@ -0,0 +1,171 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package clique
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// attestationDomain separates attestation signatures from any other data
+// signed with the signer keys.
+const attestationDomain = "clique-attestation"
+
+var (
+	// errNoLocalSigner is returned if an attestation is requested without a
+	// signer authorized on the node.
+	errNoLocalSigner = errors.New("no local signer")
+
+	// ErrInvalidAttestation is returned if an attestation signature is malformed.
+	ErrInvalidAttestation = errors.New("invalid attestation signature")
+)
+
+// Attestation is a statement of a signer that a block has been sealed on top
+// by more than half of the signers. Attestations of more than half of the
+// signers on the same block form a quorum certificate finalizing the block.
+type Attestation struct {
+	Number    uint64
+	Hash      common.Hash
+	Signature []byte
+}
+
+// ID returns the unique identifier of the attestation.
+func (a *Attestation) ID() common.Hash {
+	return crypto.Keccak256Hash(attestationData(a.Number, a.Hash), a.Signature)
+}
+
+// attestationData returns the data signed by an attestation.
+func attestationData(number uint64, hash common.Hash) []byte {
+	data, _ := rlp.EncodeToBytes([]interface{}{attestationDomain, number, hash})
+	return data
+}
+
+// DecodeAttestationData decodes the number and hash of the attested block from
+// the data signed by an attestation.
+func DecodeAttestationData(data []byte) (uint64, common.Hash, error) {
+	var dec struct {
+		Domain string
+		Number uint64
+		Hash   common.Hash
+	}
+	if err := rlp.DecodeBytes(data, &dec); err != nil {
+		return 0, common.Hash{}, err
+	}
+	if dec.Domain != attestationDomain {
+		return 0, common.Hash{}, fmt.Errorf("invalid attestation domain %q", dec.Domain)
+	}
+	return dec.Number, dec.Hash, nil
+}
+
+// Attest signs an attestation of the block with the given number and hash by
+// the local signer.
+func (c *Clique) Attest(number uint64, hash common.Hash) (*Attestation, error) {
+	c.lock.RLock()
+	signer, signFn := c.signer, c.signFn
+	c.lock.RUnlock()
+
+	if signFn == nil {
+		return nil, errNoLocalSigner
+	}
+	sig, err := signFn(accounts.Account{Address: signer}, accounts.MimetypeCliqueAttestation, attestationData(number, hash))
+	if err != nil {
+		return nil, err
+	}
+	return &Attestation{Number: number, Hash: hash, Signature: sig}, nil
+}
+
+// AttestationSigner recovers the signer of the attestation and ensures it is
+// authorized to sign the attested block.
+func (c *Clique) AttestationSigner(chain consensus.ChainHeaderReader, a *Attestation) (common.Address, error) {
+	if len(a.Signature) != crypto.SignatureLength {
+		return common.Address{}, ErrInvalidAttestation
+	}
+	pubkey, err := crypto.Ecrecover(crypto.Keccak256(attestationData(a.Number, a.Hash)), a.Signature)
+	if err != nil {
+		return common.Address{}, ErrInvalidAttestation
+	}
+	var signer common.Address
+	copy(signer[:], crypto.Keccak256(pubkey[1:])[12:])
+
+	snap, err := c.snapshot(chain, a.Number, a.Hash, nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if _, ok := snap.Signers[signer]; !ok {
+		return common.Address{}, errUnauthorizedSigner
+	}
+	return signer, nil
+}
+
+// LocalSigner returns the signer authorized on the node, if any.
+func (c *Clique) LocalSigner() (common.Address, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.signer, c.signFn != nil
+}
+
+// AttestationTarget returns the highest ancestor of the given head, which has
+// been sealed on top by more than half of the signers, thus can be attested.
+// Nil is returned if there is no such block yet.
+func (c *Clique) AttestationTarget(chain consensus.ChainHeaderReader, head *types.Header) (*types.Header, error) {
+	snap, err := c.snapshot(chain, head.Number.Uint64(), head.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		quorum  = len(snap.Signers)/2 + 1
+		signers = make(map[common.Address]struct{})
+		header  = head
+	)
+	for header.Number.Uint64() > 0 {
+		signer, err := ecrecover(header, c.signatures)
+		if err != nil {
+			return nil, err
+		}
+		signers[signer] = struct{}{}
+
+		if header = chain.GetHeader(header.ParentHash, header.Number.Uint64()-1); header == nil {
+			return nil, consensus.ErrUnknownAncestor
+		}
+		if len(signers) >= quorum {
+			return header, nil
+		}
+		// Bail out if the sealers are too few to ever reach the quorum
+		if head.Number.Uint64()-header.Number.Uint64() > uint64(len(snap.Signers)) {
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+// AttestationQuorum returns the number of distinct signers required to attest
+// the block with the given number and hash to finalize it.
+func (c *Clique) AttestationQuorum(chain consensus.ChainHeaderReader, number uint64, hash common.Hash) (int, error) {
+	snap, err := c.snapshot(chain, number, hash, nil)
+	if err != nil {
+		return 0, err
+	}
+	return len(snap.Signers)/2 + 1, nil
+}
@@ -176,7 +176,9 @@ type Clique struct {
 	recents    *lru.Cache[common.Hash, *Snapshot] // Snapshots for recent block to speed up reorgs
 	signatures *sigLRU                            // Signatures of recent blocks to speed up mining
 
-	proposals map[common.Address]bool // Current list of proposals we are pushing
+	proposals    map[common.Address]*Proposal // Current list of proposals we are pushing
+	nextProposal uint64                       // Identifier of the next proposal to persist
+	voteHead     *types.Header                // Last canonical head the proposal votes were recorded at
 
 	signer common.Address // Ethereum address of the signing key
 	signFn SignerFn       // Signer function to authorize hashes with
@@ -198,13 +200,15 @@ func New(config *params.CliqueConfig, db ethdb.Database) *Clique {
 	recents := lru.NewCache[common.Hash, *Snapshot](inmemorySnapshots)
 	signatures := lru.NewCache[common.Hash, common.Address](inmemorySignatures)
 
-	return &Clique{
+	clique := &Clique{
 		config:     &conf,
 		db:         db,
 		recents:    recents,
 		signatures: signatures,
-		proposals:  make(map[common.Address]bool),
+		proposals:  make(map[common.Address]*Proposal),
 	}
+	clique.restoreProposals()
+	return clique
 }
 
 // Author implements consensus.Engine, returning the Ethereum address recovered
@@ -352,15 +356,17 @@ func (c *Clique) verifyCascadingFields(chain consensus.ChainHeaderReader, header
 	if err != nil {
 		return err
 	}
-	// If the block is a checkpoint block, verify the signer list
+	// If the block is a checkpoint block, verify the signer list. Registry based
+	// lists can only be verified having the parent state. Chains processing the
+	// blocks verify them along the block body if the parent is not processed yet,
+	// header-only chains (light client, snap sync) would never verify them, thus
+	// can't accept them.
 	if number%c.config.Epoch == 0 {
-		signers := make([]byte, len(snap.Signers)*common.AddressLength)
-		for i, signer := range snap.signers() {
-			copy(signers[i*common.AddressLength:], signer[:])
-		}
-		extraSuffix := len(header.Extra) - extraSeal
-		if !bytes.Equal(header.Extra[extraVanity:extraSuffix], signers) {
-			return errMismatchingCheckpointSigners
+		err := c.verifyCheckpointSigners(chain, snap, parent, header)
+		if _, processing := chain.(stateReader); processing && errors.Is(err, errRegistryUnavailable) {
+			log.Debug("Deferring checkpoint signers verification", "number", number, "err", err)
+		} else if err != nil {
+			return err
 		}
 	}
 	// All basic checks passed, verify the seal and return
@@ -396,12 +402,7 @@ func (c *Clique) snapshot(chain consensus.ChainHeaderReader, number uint64, hash
 			checkpoint := chain.GetHeaderByNumber(number)
 			if checkpoint != nil {
 				hash := checkpoint.Hash()
-
-				signers := make([]common.Address, (len(checkpoint.Extra)-extraVanity-extraSeal)/common.AddressLength)
-				for i := 0; i < len(signers); i++ {
-					copy(signers[i][:], checkpoint.Extra[extraVanity+i*common.AddressLength:])
-				}
-				snap = newSnapshot(c.config, c.signatures, number, hash, signers)
+				snap = newSnapshot(c.config, c.signatures, number, hash, extractSigners(checkpoint))
 				if err := snap.store(c.db); err != nil {
 					return nil, err
 				}
@@ -450,10 +451,49 @@ func (c *Clique) snapshot(chain consensus.ChainHeaderReader, number uint64, hash
 
 // VerifyUncles implements consensus.Engine, always returning an error for any
 // uncles as this consensus mechanism doesn't permit uncles.
+//
+// As the body of every block is verified right before processing it, when the
+// parent state is available, registry based checkpoint signers the header
+// verification had to skip are verified here too.
 func (c *Clique) VerifyUncles(chain consensus.ChainReader, block *types.Block) error {
 	if len(block.Uncles()) > 0 {
 		return errors.New("uncles not allowed")
 	}
+	header := block.Header()
+	number := header.Number.Uint64()
+	if number == 0 || number%c.config.Epoch != 0 || !c.config.IsRegistry(number) {
+		return nil
+	}
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	snap, err := c.snapshot(chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		return err
+	}
+	// Missing parent state is reported by the body validation itself
+	if err := c.verifyCheckpointSigners(chain, snap, parent, header); !errors.Is(err, errRegistryUnavailable) {
+		return err
+	}
+	return nil
+}
+
+// verifyCheckpointSigners checks that the signer list embedded into the
+// checkpoint header matches the signers expected after the parent.
+func (c *Clique) verifyCheckpointSigners(chain consensus.ChainHeaderReader, snap *Snapshot, parent, header *types.Header) error {
+	checkpoint, err := c.checkpointSigners(chain, snap, parent)
+	if err != nil {
+		return err
+	}
+	signers := make([]byte, len(checkpoint)*common.AddressLength)
+	for i, signer := range checkpoint {
+		copy(signers[i*common.AddressLength:], signer[:])
+	}
+	extraSuffix := len(header.Extra) - extraSeal
+	if !bytes.Equal(header.Extra[extraVanity:extraSuffix], signers) {
+		return errMismatchingCheckpointSigners
+	}
 	return nil
 }
 
@@ -509,19 +549,22 @@ func (c *Clique) Prepare(chain consensus.ChainHeaderReader, header *types.Header
 	if err != nil {
 		return err
 	}
+	c.recordVotes(chain, chain.CurrentHeader())
+	c.expireProposals(number)
+
 	c.lock.RLock()
-	if number%c.config.Epoch != 0 {
+	if number%c.config.Epoch != 0 && !c.config.IsRegistry(number) {
 		// Gather all the proposals that make sense voting on
 		addresses := make([]common.Address, 0, len(c.proposals))
-		for address, authorize := range c.proposals {
-			if snap.validVote(address, authorize) {
+		for address, proposal := range c.proposals {
+			if snap.validVote(address, proposal.Authorize) {
 				addresses = append(addresses, address)
 			}
 		}
 		// If there's pending proposals, cast a vote on them
 		if len(addresses) > 0 {
 			header.Coinbase = addresses[rand.Intn(len(addresses))]
-			if c.proposals[header.Coinbase] {
+			if c.proposals[header.Coinbase].Authorize {
 				copy(header.Nonce[:], nonceAuthVote)
 			} else {
 				copy(header.Nonce[:], nonceDropVote)
@@ -542,8 +585,16 @@ func (c *Clique) Prepare(chain consensus.ChainHeaderReader, header *types.Header
 	}
 	header.Extra = header.Extra[:extraVanity]
 
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
 	if number%c.config.Epoch == 0 {
-		for _, signer := range snap.signers() {
+		signers, err := c.checkpointSigners(chain, snap, parent)
+		if err != nil {
+			return err
+		}
+		for _, signer := range signers {
 			header.Extra = append(header.Extra, signer[:]...)
 		}
 	}
@@ -553,10 +604,6 @@ func (c *Clique) Prepare(chain consensus.ChainHeaderReader, header *types.Header
 	header.MixDigest = common.Hash{}
 
 	// Ensure the timestamp has the correct delay
-	parent := chain.GetHeader(header.ParentHash, number-1)
-	if parent == nil {
-		return consensus.ErrUnknownAncestor
-	}
 	header.Time = parent.Time + c.config.Period
 	if header.Time < uint64(time.Now().Unix()) {
 		header.Time = uint64(time.Now().Unix())
@@ -0,0 +1,324 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package clique
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	maxProposalVotes   = 128  // Number of most recent votes kept per proposal
+	maxClosedProposals = 1024 // Number of most recent closed proposals kept in the history
+)
+
+// ProposalStatus is the lifecycle state of an authorization proposal.
+type ProposalStatus string
+
+const (
+	ProposalPending   ProposalStatus = "pending"   // Proposal is voted on in sealed blocks
+	ProposalDiscarded ProposalStatus = "discarded" // Proposal was dropped with clique_discard
+	ProposalReplaced  ProposalStatus = "replaced"  // Proposal was replaced by a newer one on the same address
+	ProposalExpired   ProposalStatus = "expired"   // Proposal reached its expiry block or time
+)
+
+// ProposalVote is a vote of a proposal included in a locally sealed canonical header.
+type ProposalVote struct {
+	Number uint64      `json:"number"` // Number of the sealed block
+	Hash   common.Hash `json:"hash"`   // Hash of the sealed block
+	Time   uint64      `json:"time"`   // Timestamp of the sealed block
+}
+
+// Proposal is an authorization proposal the node tries to uphold and vote on,
+// persisted along with its lifecycle to survive restarts.
+type Proposal struct {
+	ID          uint64          `json:"id"`
+	Address     common.Address  `json:"address"`
+	Authorize   bool            `json:"authorize"`
+	Status      ProposalStatus  `json:"status"`
+	Created     uint64          `json:"created"`               // Unix time the proposal was created at
+	Closed      uint64          `json:"closed,omitempty"`      // Unix time the proposal stopped being pending at
+	ExpiryBlock uint64          `json:"expiryBlock,omitempty"` // Last block to vote on the proposal in (0 = no expiry)
+	ExpiryTime  uint64          `json:"expiryTime,omitempty"`  // Unix time the proposal expires at (0 = no expiry)
+	Votes       []*ProposalVote `json:"votes"`                 // Most recent votes included in locally sealed canonical headers
+}
+
+// expired returns whether the proposal can no longer be voted on in the block
+// with the given number at the given time.
+func (p *Proposal) expired(number uint64, now uint64) bool {
+	return (p.ExpiryBlock != 0 && number > p.ExpiryBlock) || (p.ExpiryTime != 0 && now >= p.ExpiryTime)
+}
+
+// proposalKey = CliqueProposalPrefix + id (uint64 big endian)
+func proposalKey(id uint64) []byte {
+	key := make([]byte, len(rawdb.CliqueProposalPrefix)+8)
+	copy(key, rawdb.CliqueProposalPrefix)
+	binary.BigEndian.PutUint64(key[len(rawdb.CliqueProposalPrefix):], id)
+	return key
+}
+
+// storeProposal inserts the proposal into the database.
+func storeProposal(db ethdb.KeyValueWriter, proposal *Proposal) error {
+	blob, err := json.Marshal(proposal)
+	if err != nil {
+		return err
+	}
+	return db.Put(proposalKey(proposal.ID), blob)
+}
+
+// loadProposals loads all proposals from the database in the order of creation.
+func loadProposals(db ethdb.Iteratee) ([]*Proposal, error) {
+	it := db.NewIterator(rawdb.CliqueProposalPrefix, nil)
+	defer it.Release()
+
+	var proposals []*Proposal
+	for it.Next() {
+		if len(it.Key()) != len(rawdb.CliqueProposalPrefix)+8 {
+			continue
+		}
+		proposal := new(Proposal)
+		if err := json.Unmarshal(it.Value(), proposal); err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, proposal)
+	}
+	return proposals, it.Error()
+}
+
+// restoreProposals loads the pending proposals persisted by a previous run.
+func (c *Clique) restoreProposals() {
+	proposals, err := loadProposals(c.db)
+	if err != nil {
+		log.Error("Failed to load clique proposals", "err", err)
+		return
+	}
+	for _, proposal := range proposals {
+		if proposal.ID >= c.nextProposal {
+			c.nextProposal = proposal.ID + 1
+		}
+		if proposal.Status == ProposalPending {
+			c.proposals[proposal.Address] = proposal
+		}
+	}
+	if len(c.proposals) > 0 {
+		log.Info("Restored pending clique proposals", "count", len(c.proposals))
+	}
+}
+
+// closeProposal moves the pending proposal on the given address into the given
+// final status, pruning the oldest closed proposals beyond the history limit.
+// The caller must hold the write lock.
+func (c *Clique) closeProposal(address common.Address, status ProposalStatus) {
+	proposal, ok := c.proposals[address]
+	if !ok {
+		return
+	}
+	delete(c.proposals, address)
+
+	proposal.Status, proposal.Closed = status, uint64(time.Now().Unix())
+	if err := storeProposal(c.db, proposal); err != nil {
+		log.Warn("Failed to store clique proposal", "address", address, "err", err)
+	}
+	proposals, err := loadProposals(c.db)
+	if err != nil {
+		log.Warn("Failed to load clique proposals", "err", err)
+		return
+	}
+	var closed []*Proposal
+	for _, proposal := range proposals {
+		if proposal.Status != ProposalPending {
+			closed = append(closed, proposal)
+		}
+	}
+	for len(closed) > maxClosedProposals {
+		if err := c.db.Delete(proposalKey(closed[0].ID)); err != nil {
+			log.Warn("Failed to delete clique proposal", "id", closed[0].ID, "err", err)
+		}
+		closed = closed[1:]
+	}
+}
+
+// propose persists a new pending proposal, replacing any previous one on the
+// same address.
+func (c *Clique) propose(address common.Address, authorize bool, expiryBlock, expiryTime uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.closeProposal(address, ProposalReplaced)
+
+	proposal := &Proposal{
+		ID:          c.nextProposal,
+		Address:     address,
+		Authorize:   authorize,
+		Status:      ProposalPending,
+		Created:     uint64(time.Now().Unix()),
+		ExpiryBlock: expiryBlock,
+		ExpiryTime:  expiryTime,
+		Votes:       []*ProposalVote{},
+	}
+	if err := storeProposal(c.db, proposal); err != nil {
+		log.Warn("Failed to store clique proposal", "address", address, "err", err)
+	}
+	c.nextProposal++
+	c.proposals[address] = proposal
+}
+
+// discard drops the pending proposal on the given address.
+func (c *Clique) discard(address common.Address) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.closeProposal(address, ProposalDiscarded)
+}
+
+// expireProposals drops the pending proposals which can no longer be voted on
+// in the block with the given number.
+func (c *Clique) expireProposals(number uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := uint64(time.Now().Unix())
+	for address, proposal := range c.proposals {
+		if proposal.expired(number, now) {
+			log.Info("Clique proposal expired", "address", address, "authorize", proposal.Authorize)
+			c.closeProposal(address, ProposalExpired)
+		}
+	}
+}
+
+// recordVotes updates the votes of the pending proposals to the canonical chain
+// ending in the given head, appending the votes cast in the locally sealed
+// headers added since the last update and dropping those of the headers
+// reorged out. At most maxProposalVotes headers are walked back, as more votes
+// wouldn't be kept anyway.
+func (c *Clique) recordVotes(chain consensus.ChainHeaderReader, head *types.Header) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if head == nil {
+		return
+	}
+	if len(c.proposals) == 0 {
+		c.voteHead = head
+		return
+	}
+	var (
+		added   []*types.Header
+		dropped = make(map[common.Hash]bool)
+		first   = c.voteHead == nil
+		old     = c.voteHead
+		header  = head
+	)
+	parent := func(header *types.Header) *types.Header {
+		if header.Number.Uint64() == 0 {
+			return nil
+		}
+		return chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	}
+	for header != nil && len(added) < maxProposalVotes {
+		for old != nil && old.Number.Uint64() > header.Number.Uint64() {
+			dropped[old.Hash()] = true
+			old = parent(old)
+		}
+		if old != nil && old.Hash() == header.Hash() {
+			break
+		}
+		if old != nil && old.Number.Uint64() == header.Number.Uint64() {
+			dropped[old.Hash()] = true
+			old = parent(old)
+		}
+		added = append(added, header)
+		header = parent(header)
+	}
+	c.voteHead = head
+
+	for address, proposal := range c.proposals {
+		changed := false
+
+		votes := proposal.Votes[:0]
+		for _, vote := range proposal.Votes {
+			// Votes restored after a restart are checked against the canonical chain
+			if dropped[vote.Hash] || (first && !canonical(chain, vote)) {
+				changed = true
+				continue
+			}
+			votes = append(votes, vote)
+		}
+		proposal.Votes = votes
+
+		for i := len(added) - 1; i >= 0; i-- {
+			header := added[i]
+			if header.Coinbase != address || proposal.Authorize != bytes.Equal(header.Nonce[:], nonceAuthVote) {
+				continue
+			}
+			if signer, err := ecrecover(header, c.signatures); err != nil || signer != c.signer {
+				continue
+			}
+			if n := len(proposal.Votes); n > 0 && proposal.Votes[n-1].Number >= header.Number.Uint64() {
+				continue // Already recorded before a restart
+			}
+			proposal.Votes = append(proposal.Votes, &ProposalVote{
+				Number: header.Number.Uint64(),
+				Hash:   header.Hash(),
+				Time:   header.Time,
+			})
+			changed = true
+		}
+		if len(proposal.Votes) > maxProposalVotes {
+			proposal.Votes = append([]*ProposalVote{}, proposal.Votes[len(proposal.Votes)-maxProposalVotes:]...)
+		}
+		if changed {
+			if err := storeProposal(c.db, proposal); err != nil {
+				log.Warn("Failed to store clique proposal", "address", address, "err", err)
+			}
+		}
+	}
+}
+
+// canonical returns whether the block the vote was included in is canonical.
+func canonical(chain consensus.ChainHeaderReader, vote *ProposalVote) bool {
+	header := chain.GetHeaderByNumber(vote.Number)
+	return header != nil && header.Hash() == vote.Hash
+}
+
+// proposalHistory returns all persisted proposals in the order of creation,
+// optionally filtered by the proposed address.
+func (c *Clique) proposalHistory(address *common.Address) ([]*Proposal, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	proposals, err := loadProposals(c.db)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]*Proposal, 0, len(proposals))
+	for _, proposal := range proposals {
+		if address == nil || proposal.Address == *address {
+			history = append(history, proposal)
+		}
+	}
+	return history, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package clique
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testerHeaderChain is a header chain with a switchable canonical chain.
+type testerHeaderChain struct {
+	consensus.ChainHeaderReader
+	headers   map[common.Hash]*types.Header
+	canonical []*types.Header
+}
+
+func (c *testerHeaderChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	return c.headers[hash]
+}
+
+func (c *testerHeaderChain) GetHeaderByNumber(number uint64) *types.Header {
+	if number >= uint64(len(c.canonical)) {
+		return nil
+	}
+	return c.canonical[number]
+}
+
+// extend appends a header sealed by the signer, casting the given vote, on top
+// of the parent.
+func (c *testerHeaderChain) extend(accounts *testerAccountPool, parent *types.Header, signer string, vote common.Address, auth bool) *types.Header {
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		Time:       parent.Time + 10 + uint64(len(c.headers)),
+		Coinbase:   vote,
+		Difficulty: diffNoTurn,
+		Extra:      make([]byte, extraVanity+extraSeal),
+	}
+	if auth {
+		copy(header.Nonce[:], nonceAuthVote)
+	}
+	accounts.sign(header, signer)
+	c.headers[header.Hash()] = header
+	return header
+}
+
+func TestProposalLifecycle(t *testing.T) {
+	var (
+		db       = rawdb.NewMemoryDatabase()
+		config   = &params.CliqueConfig{Period: 1, Epoch: 30000}
+		accounts = newTesterAccountPool()
+		a, b     = common.Address{1}, common.Address{2}
+		expiry   = hexutil.Uint64(5)
+	)
+	api := &API{clique: New(config, db)}
+	api.clique.Authorize(accounts.address("A"), nil)
+	api.Propose(a, false, nil)
+	api.Propose(b, false, nil)
+	api.Discard(b)
+	api.Propose(a, true, &ProposalExpiry{Block: &expiry})
+
+	// Votes are recorded for locally sealed canonical headers matching pending proposals only
+	genesis := &types.Header{Number: new(big.Int), Extra: make([]byte, extraVanity+extraSeal)}
+	chain := &testerHeaderChain{headers: map[common.Hash]*types.Header{genesis.Hash(): genesis}}
+	h1 := chain.extend(accounts, genesis, "A", a, false)
+	h2 := chain.extend(accounts, h1, "A", a, true)
+	h3 := chain.extend(accounts, h2, "A", a, true)
+	h4 := chain.extend(accounts, h3, "B", a, true)
+	chain.canonical = []*types.Header{genesis, h1, h2, h3, h4}
+
+	api.clique.recordVotes(chain, h4)
+	history, err := api.GetProposalHistory(&a)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, []*ProposalVote{{Number: 2, Hash: h2.Hash(), Time: h2.Time}, {Number: 3, Hash: h3.Hash(), Time: h3.Time}}, history[1].Votes)
+
+	// Votes of reorged headers are dropped
+	f3 := chain.extend(accounts, h2, "A", a, true)
+	chain.canonical = []*types.Header{genesis, h1, h2, f3}
+
+	api.clique.recordVotes(chain, f3)
+	history, err = api.GetProposalHistory(&a)
+	require.NoError(t, err)
+	assert.Equal(t, []*ProposalVote{{Number: 2, Hash: h2.Hash(), Time: h2.Time}, {Number: 3, Hash: f3.Hash(), Time: f3.Time}}, history[1].Votes)
+
+	// Pending proposals survive a restart, their votes are checked against the canonical chain
+	chain.canonical = []*types.Header{genesis, h1, h2, h3, h4}
+
+	api = &API{clique: New(config, db)}
+	api.clique.Authorize(accounts.address("A"), nil)
+	assert.Equal(t, map[common.Address]bool{a: true}, api.Proposals())
+	api.clique.recordVotes(chain, h4)
+
+	history, err = api.GetProposalHistory(nil)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	for i, want := range []struct {
+		address   common.Address
+		authorize bool
+		status    ProposalStatus
+		votes     int
+	}{
+		{a, false, ProposalReplaced, 0},
+		{b, false, ProposalDiscarded, 0},
+		{a, true, ProposalPending, 2},
+	} {
+		assert.Equal(t, uint64(i), history[i].ID)
+		assert.Equal(t, want.address, history[i].Address, "proposal %d", i)
+		assert.Equal(t, want.authorize, history[i].Authorize, "proposal %d", i)
+		assert.Equal(t, want.status, history[i].Status, "proposal %d", i)
+		assert.Len(t, history[i].Votes, want.votes, "proposal %d", i)
+	}
+	assert.Equal(t, &ProposalVote{Number: 3, Hash: h3.Hash(), Time: h3.Time}, history[2].Votes[1])
+
+	// Proposals expire after the expiry block
+	api.clique.expireProposals(5)
+	assert.Len(t, api.Proposals(), 1)
+	api.clique.expireProposals(6)
+	assert.Empty(t, api.Proposals())
+
+	history, err = api.GetProposalHistory(&a)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, ProposalExpired, history[1].Status)
+
+	// New proposals continue the numbering after a restart
+	api = &API{clique: New(config, db)}
+	api.Propose(b, true, nil)
+	history, err = api.GetProposalHistory(&b)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, uint64(3), history[1].ID)
+}
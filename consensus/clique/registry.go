@@ -0,0 +1,121 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package clique
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// maxRegistrySigners is the maximum number of signers the validator registry
+// can hold, registries exceeding it are ignored to bound the checkpoint size.
+const maxRegistrySigners = 256
+
+// registrySignersSlot is the storage slot of the validators array in the
+// registry contract, declared as the first state variable (address[] validators).
+var registrySignersSlot = common.Hash{}
+
+// errRegistryUnavailable is returned if the state needed to read the validator
+// registry is not available locally (e.g. header-only sync or a block whose
+// parent is not processed yet). Header-only chains reject registry checkpoints
+// with this error, since they never get to verify them.
+var errRegistryUnavailable = errors.New("validator registry state unavailable")
+
+// stateReader is implemented by chains able to provide the state of processed
+// blocks, which is required to read the validator registry.
+type stateReader interface {
+	StateAt(root common.Hash) (*state.StateDB, error)
+}
+
+// registryStateReader is a minimal state accessor required for reading the
+// validator registry contract storage.
+type registryStateReader interface {
+	GetState(common.Address, common.Hash) common.Hash
+}
+
+// readRegistrySigners reads the list of signers from the validator registry
+// contract storage, sorted in ascending order with duplicates and zero
+// addresses dropped. Nil is returned if the registry holds no signers or
+// exceeds the maximum number of signers.
+func readRegistrySigners(statedb registryStateReader, registry common.Address) []common.Address {
+	length := statedb.GetState(registry, registrySignersSlot).Big()
+	if length.Sign() == 0 || length.Cmp(big.NewInt(maxRegistrySigners)) > 0 {
+		return nil
+	}
+	var (
+		base    = crypto.Keccak256Hash(registrySignersSlot.Bytes()).Big()
+		seen    = make(map[common.Address]struct{})
+		signers = make([]common.Address, 0, length.Uint64())
+	)
+	for i := uint64(0); i < length.Uint64(); i++ {
+		slot := common.BigToHash(new(big.Int).Add(base, new(big.Int).SetUint64(i)))
+		signer := common.BytesToAddress(statedb.GetState(registry, slot).Bytes())
+		if _, ok := seen[signer]; ok || signer == (common.Address{}) {
+			continue
+		}
+		seen[signer] = struct{}{}
+		signers = append(signers, signer)
+	}
+	if len(signers) == 0 {
+		return nil
+	}
+	sort.Sort(signersAscending(signers))
+	return signers
+}
+
+// checkpointSigners returns the list of signers to embed into the checkpoint
+// header following the given parent. Since the validator registry fork, signers
+// are read from the registry contract in the state of the parent block, falling
+// back to the current signers if the registry is empty or invalid.
+func (c *Clique) checkpointSigners(chain consensus.ChainHeaderReader, snap *Snapshot, parent *types.Header) ([]common.Address, error) {
+	number := parent.Number.Uint64() + 1
+	if !c.config.IsRegistry(number) {
+		return snap.signers(), nil
+	}
+	reader, ok := chain.(stateReader)
+	if !ok {
+		return nil, errRegistryUnavailable
+	}
+	statedb, err := reader.StateAt(parent.Root)
+	if err != nil {
+		return nil, errRegistryUnavailable
+	}
+	signers := readRegistrySigners(statedb, chain.Config().SystemContracts.ValidatorRegistry)
+	if signers == nil {
+		log.Warn("Invalid validator registry, keeping current signers", "number", number)
+		return snap.signers(), nil
+	}
+	return signers, nil
+}
+
+// extractSigners retrieves the list of signers embedded into the extra-data
+// section of a checkpoint header.
+func extractSigners(header *types.Header) []common.Address {
+	signers := make([]common.Address, (len(header.Extra)-extraVanity-extraSeal)/common.AddressLength)
+	for i := 0; i < len(signers); i++ {
+		copy(signers[i][:], header.Extra[extraVanity+i*common.AddressLength:])
+	}
+	return signers
+}
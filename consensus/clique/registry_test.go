@@ -0,0 +1,139 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package clique
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// registryStorage returns the registry contract storage holding the given list of signers.
+func registryStorage(signers ...common.Address) map[common.Hash]common.Hash {
+	storage := map[common.Hash]common.Hash{
+		registrySignersSlot: common.BigToHash(big.NewInt(int64(len(signers)))),
+	}
+	base := crypto.Keccak256Hash(registrySignersSlot.Bytes()).Big()
+	for i, signer := range signers {
+		storage[common.BigToHash(new(big.Int).Add(base, big.NewInt(int64(i))))] = signer.Hash()
+	}
+	return storage
+}
+
+func TestReadRegistrySigners(t *testing.T) {
+	registry := common.HexToAddress("0x0000000000000000000000000000000000001002")
+	a, b, c := common.Address{1}, common.Address{2}, common.Address{3}
+
+	tests := []struct {
+		signers []common.Address
+		want    []common.Address
+	}{
+		{signers: nil, want: nil},
+		{signers: []common.Address{c, a, b}, want: []common.Address{a, b, c}},
+		{signers: []common.Address{b, {}, a, b}, want: []common.Address{a, b}},
+		{signers: []common.Address{{}}, want: nil},
+		{signers: make([]common.Address, maxRegistrySigners+1), want: nil},
+	}
+	for i, tt := range tests {
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		for slot, value := range registryStorage(tt.signers...) {
+			statedb.SetState(registry, slot, value)
+		}
+		assert.Equal(t, tt.want, readRegistrySigners(statedb, registry), "test %d", i)
+	}
+}
+
+func TestRegistryCheckpoint(t *testing.T) {
+	accounts := newTesterAccountPool()
+	signers := []common.Address{accounts.address("A"), accounts.address("B"), accounts.address("C")}
+	sort.Sort(signersAscending(signers))
+
+	// The registry drops the last signer on the first checkpoint since the fork
+	registry := common.HexToAddress("0x0000000000000000000000000000000000001002")
+	genesis := newTesterGenesis(&params.CliqueConfig{Period: 1, Epoch: 4, RegistryBlock: big.NewInt(4)})
+	genesis.Config.SystemContracts = &params.SystemContracts{ValidatorRegistry: registry}
+	genesis.Alloc = core.GenesisAlloc{
+		registry: {Balance: new(big.Int), Storage: registryStorage(signers[1], signers[0])},
+	}
+	chain, engine := newTesterChain(t, accounts, genesis, []string{"A", "B", "C"}, []int{1, 2, 0, 1, 0, 1, 0, 1})
+	defer chain.Stop()
+
+	checkpoint := chain.GetHeaderByNumber(4)
+	assert.Equal(t, signers[:2], extractSigners(checkpoint))
+
+	snap, err := engine.snapshot(chain, 8, chain.GetHeaderByNumber(8).Hash(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, signers[:2], snap.signers())
+
+	// A checkpoint carrying the vote based signer list is rejected
+	header := types.CopyHeader(checkpoint)
+	header.Extra = make([]byte, extraVanity, extraVanity+len(signers)*common.AddressLength+extraSeal)
+	for _, signer := range signers {
+		header.Extra = append(header.Extra, signer[:]...)
+	}
+	header.Extra = append(header.Extra, make([]byte, extraSeal)...)
+	for _, name := range []string{"A", "B", "C"} {
+		if accounts.address(name) == signers[1] {
+			accounts.sign(header, name)
+		}
+	}
+	assert.ErrorIs(t, engine.VerifyHeader(chain, header, true), errMismatchingCheckpointSigners)
+
+	// Without the parent processed yet the header verification defers to the body verification
+	assert.NoError(t, engine.VerifyHeader(unprocessedChain{chain}, header, true))
+	assert.ErrorIs(t, engine.VerifyUncles(chain, types.NewBlockWithHeader(header)), errMismatchingCheckpointSigners)
+	assert.NoError(t, engine.VerifyUncles(chain, chain.GetBlockByNumber(4)))
+
+	// Header-only chains never verify the body, the checkpoint is rejected
+	assert.ErrorIs(t, engine.VerifyHeader(struct{ consensus.ChainHeaderReader }{chain}, header, true), errRegistryUnavailable)
+	assert.ErrorIs(t, engine.VerifyHeader(struct{ consensus.ChainHeaderReader }{chain}, checkpoint, true), errRegistryUnavailable)
+
+	headers := make([]*types.Header, 8)
+	for i := range headers {
+		headers[i] = chain.GetHeaderByNumber(uint64(i + 1))
+	}
+	light, err := core.NewBlockChain(rawdb.NewMemoryDatabase(), nil, genesis, nil, New(genesis.Config.Clique, rawdb.NewMemoryDatabase()), vm.Config{}, nil, nil)
+	require.NoError(t, err)
+	defer light.Stop()
+
+	_, err = light.InsertHeaderChain(headers, 1)
+	assert.ErrorIs(t, err, errRegistryUnavailable)
+	assert.Zero(t, light.CurrentHeader().Number.Uint64())
+}
+
+// unprocessedChain is a chain whose blocks have not been processed yet, as during
+// the import of a batch of blocks.
+type unprocessedChain struct {
+	consensus.ChainHeaderReader
+}
+
+func (unprocessedChain) StateAt(root common.Hash) (*state.StateDB, error) {
+	return nil, errors.New("missing trie node")
+}
@@ -60,6 +60,9 @@ type Snapshot struct {
 	Recents map[uint64]common.Address   `json:"recents"` // Set of recent signers for spam protections
 	Votes   []*Vote                     `json:"votes"`   // List of votes cast in chronological order
 	Tally   map[common.Address]Tally    `json:"tally"`   // Current vote tally to avoid recalculating
+
+	Missed    map[common.Address]uint64   `json:"missed,omitempty"`    // Consecutive in-turn slots missed by signers
+	Suspended map[common.Address]struct{} `json:"suspended,omitempty"` // Signers suspended from the in-turn rotation
 }
 
 // signersAscending implements the sort interface to allow sorting a list of addresses
@@ -74,13 +77,15 @@ func (s signersAscending) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 // the genesis block.
 func newSnapshot(config *params.CliqueConfig, sigcache *sigLRU, number uint64, hash common.Hash, signers []common.Address) *Snapshot {
 	snap := &Snapshot{
-		config:   config,
-		sigcache: sigcache,
-		Number:   number,
-		Hash:     hash,
-		Signers:  make(map[common.Address]struct{}),
-		Recents:  make(map[uint64]common.Address),
-		Tally:    make(map[common.Address]Tally),
+		config:    config,
+		sigcache:  sigcache,
+		Number:    number,
+		Hash:      hash,
+		Signers:   make(map[common.Address]struct{}),
+		Recents:   make(map[uint64]common.Address),
+		Tally:     make(map[common.Address]Tally),
+		Missed:    make(map[common.Address]uint64),
+		Suspended: make(map[common.Address]struct{}),
 	}
 	for _, signer := range signers {
 		snap.Signers[signer] = struct{}{}
@@ -116,14 +121,16 @@ func (s *Snapshot) store(db ethdb.Database) error {
 // copy creates a deep copy of the snapshot, though not the individual votes.
 func (s *Snapshot) copy() *Snapshot {
 	cpy := &Snapshot{
-		config:   s.config,
-		sigcache: s.sigcache,
-		Number:   s.Number,
-		Hash:     s.Hash,
-		Signers:  make(map[common.Address]struct{}),
-		Recents:  make(map[uint64]common.Address),
-		Votes:    make([]*Vote, len(s.Votes)),
-		Tally:    make(map[common.Address]Tally),
+		config:    s.config,
+		sigcache:  s.sigcache,
+		Number:    s.Number,
+		Hash:      s.Hash,
+		Signers:   make(map[common.Address]struct{}),
+		Recents:   make(map[uint64]common.Address),
+		Votes:     make([]*Vote, len(s.Votes)),
+		Tally:     make(map[common.Address]Tally),
+		Missed:    make(map[common.Address]uint64),
+		Suspended: make(map[common.Address]struct{}),
 	}
 	for signer := range s.Signers {
 		cpy.Signers[signer] = struct{}{}
@@ -134,6 +141,12 @@ func (s *Snapshot) copy() *Snapshot {
 	for address, tally := range s.Tally {
 		cpy.Tally[address] = tally
 	}
+	for signer, missed := range s.Missed {
+		cpy.Missed[signer] = missed
+	}
+	for signer := range s.Suspended {
+		cpy.Suspended[signer] = struct{}{}
+	}
 	copy(cpy.Votes, s.Votes)
 
 	return cpy
@@ -225,10 +238,11 @@ func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
 		if _, ok := snap.Signers[signer]; !ok {
 			return nil, errUnauthorizedSigner
 		}
-		for _, recent := range snap.Recents {
-			if recent == signer {
-				return nil, errRecentlySigned
-			}
+		if snap.recentlySigned(signer) {
+			return nil, errRecentlySigned
+		}
+		if s.config.IsSuspension(number) {
+			snap.trackInturn(number, signer)
 		}
 		snap.Recents[number] = signer
 
@@ -253,7 +267,7 @@ func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
 		default:
 			return nil, errInvalidVote
 		}
-		if snap.cast(header.Coinbase, authorize) {
+		if !s.config.IsRegistry(number) && snap.cast(header.Coinbase, authorize) {
 			snap.Votes = append(snap.Votes, &Vote{
 				Signer:    signer,
 				Block:     number,
@@ -267,6 +281,8 @@ func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
 				snap.Signers[header.Coinbase] = struct{}{}
 			} else {
 				delete(snap.Signers, header.Coinbase)
+				delete(snap.Missed, header.Coinbase)
+				delete(snap.Suspended, header.Coinbase)
 
 				// Signer list shrunk, delete any leftover recent caches
 				if limit := uint64(len(snap.Signers)/2 + 1); number >= limit {
@@ -294,6 +310,25 @@ func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
 			}
 			delete(snap.Tally, header.Coinbase)
 		}
+		// Since the validator registry fork, signers are replaced on checkpoint blocks
+		if number%s.config.Epoch == 0 && s.config.IsRegistry(number) {
+			snap.Signers = make(map[common.Address]struct{})
+			for _, signer := range extractSigners(header) {
+				snap.Signers[signer] = struct{}{}
+			}
+			// Signer list might have shrunk, delete any leftover recent caches
+			limit := uint64(len(snap.Signers)/2 + 1)
+			for block := range snap.Recents {
+				if block+limit <= number {
+					delete(snap.Recents, block)
+				}
+			}
+		}
+		// Reset suspensions on checkpoint blocks, as the snapshot can be recreated from them
+		if number%s.config.Epoch == 0 {
+			snap.Missed = make(map[common.Address]uint64)
+			snap.Suspended = make(map[common.Address]struct{})
+		}
 		// If we're taking too much time (ecrecover), notify the user once a while
 		if time.Since(logged) > 8*time.Second {
 			log.Info("Reconstructing voting history", "processed", i, "total", len(headers), "elapsed", common.PrettyDuration(time.Since(start)))
@@ -319,11 +354,73 @@ func (s *Snapshot) signers() []common.Address {
 	return sigs
 }
 
+// rotation retrieves the list of signers taking part in the in-turn rotation
+// in ascending order, which are all authorized signers except suspended ones.
+func (s *Snapshot) rotation() []common.Address {
+	signers := s.signers()
+	if len(s.Suspended) == 0 {
+		return signers
+	}
+	rotation := make([]common.Address, 0, len(signers))
+	for _, signer := range signers {
+		if _, suspended := s.Suspended[signer]; !suspended {
+			rotation = append(rotation, signer)
+		}
+	}
+	return rotation
+}
+
+// inturnSigner returns the signer which is in-turn at a given block height.
+func (s *Snapshot) inturnSigner(number uint64) common.Address {
+	rotation := s.rotation()
+
+	// While some signers are suspended, the turn is passed to the signer next to
+	// the previous block one, so that the rotation is not misaligned with the
+	// recent signers limit due to the shorter list of signers
+	if len(rotation) < len(s.Signers) {
+		if last, ok := s.Recents[number-1]; ok {
+			for _, signer := range rotation {
+				if bytes.Compare(signer[:], last[:]) > 0 {
+					return signer
+				}
+			}
+			return rotation[0]
+		}
+	}
+	return rotation[number%uint64(len(rotation))]
+}
+
 // inturn returns if a signer at a given block height is in-turn or not.
 func (s *Snapshot) inturn(number uint64, signer common.Address) bool {
-	signers, offset := s.signers(), 0
-	for offset < len(signers) && signers[offset] != signer {
-		offset++
+	return s.inturnSigner(number) == signer
+}
+
+// recentlySigned returns whether the signer is not allowed to seal the next
+// block due to signing one of the recent blocks.
+func (s *Snapshot) recentlySigned(signer common.Address) bool {
+	for _, recent := range s.Recents {
+		if recent == signer {
+			return true
+		}
+	}
+	return false
+}
+
+// trackInturn updates missed in-turn slots of signers, given the block at the
+// specified height is sealed by the signer. A signer is suspended from the
+// in-turn rotation after missing the configured number of consecutive in-turn
+// slots, unless there would not be enough signers left to seal blocks. Slots,
+// which the in-turn signer could not seal due to the recent signers limit, are
+// not counted. A suspended signer is re-admitted as soon as it seals a block.
+func (s *Snapshot) trackInturn(number uint64, signer common.Address) {
+	if expected := s.inturnSigner(number); expected != signer && !s.recentlySigned(expected) {
+		s.Missed[expected]++
+
+		if s.Missed[expected] >= s.config.SuspensionThreshold && len(s.rotation()) > len(s.Signers)/2+1 {
+			log.Debug("Suspending offline clique signer", "number", number, "signer", expected, "missed", s.Missed[expected])
+			s.Suspended[expected] = struct{}{}
+		}
 	}
-	return (number % uint64(len(signers))) == uint64(offset)
+	delete(s.Missed, signer)
+	delete(s.Suspended, signer)
 }
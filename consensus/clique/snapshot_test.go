@@ -86,6 +86,74 @@ func (ap *testerAccountPool) sign(header *types.Header, signer string) {
 	copy(header.Extra[len(header.Extra)-extraSeal:], sig)
 }
 
+// newTesterGenesis creates a genesis of a test chain using the given clique config.
+func newTesterGenesis(cliqueConfig *params.CliqueConfig) *core.Genesis {
+	config := *params.TestChainConfig
+	config.CepheusBlock = big.NewInt(0)
+	config.Clique = cliqueConfig
+
+	return &core.Genesis{Config: &config, BaseFee: big.NewInt(params.InitialBaseFee)}
+}
+
+// newTesterChain creates a chain from the genesis with the given signers, sealing
+// each block by the signer with the specified index (in ascending order of signer
+// addresses).
+func newTesterChain(t *testing.T, accounts *testerAccountPool, genesis *core.Genesis, signers []string, sealers []int) (*core.BlockChain, *Clique) {
+	names := make(map[common.Address]string)
+	addresses := make([]common.Address, len(signers))
+	for i, signer := range signers {
+		addresses[i] = accounts.address(signer)
+		names[addresses[i]] = signer
+	}
+	sort.Sort(signersAscending(addresses))
+
+	genesis.ExtraData = make([]byte, extraVanity+common.AddressLength*len(addresses)+extraSeal)
+	for i, signer := range addresses {
+		copy(genesis.ExtraData[extraVanity+i*common.AddressLength:], signer[:])
+	}
+	db := rawdb.NewMemoryDatabase()
+	engine := New(genesis.Config.Clique, db)
+	_, blocks, _ := core.GenerateChainWithGenesis(genesis, engine, len(sealers), func(i int, gen *core.BlockGen) {})
+
+	chain, err := core.NewBlockChain(db, nil, genesis, nil, engine, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create test chain: %v", err)
+	}
+	// Seal and import blocks one by one, as the difficulty depends on the parent snapshot
+	for i, block := range blocks {
+		header := block.Header()
+		if i > 0 {
+			header.ParentHash = blocks[i-1].Hash()
+		}
+		snap, err := engine.snapshot(chain, header.Number.Uint64()-1, header.ParentHash, nil)
+		if err != nil {
+			t.Fatalf("failed to retrieve snapshot of block %d: %v", i, err)
+		}
+		header.Extra = make([]byte, extraVanity, extraVanity+extraSeal)
+		if header.Number.Uint64()%engine.config.Epoch == 0 {
+			checkpoint, err := engine.checkpointSigners(chain, snap, chain.GetHeaderByHash(header.ParentHash))
+			if err != nil {
+				t.Fatalf("failed to retrieve checkpoint signers of block %d: %v", i, err)
+			}
+			for _, signer := range checkpoint {
+				header.Extra = append(header.Extra, signer[:]...)
+			}
+		}
+		header.Extra = append(header.Extra, make([]byte, extraSeal)...)
+		header.Difficulty = diffNoTurn
+		if snap.inturn(header.Number.Uint64(), addresses[sealers[i]]) {
+			header.Difficulty = diffInTurn
+		}
+		accounts.sign(header, names[addresses[sealers[i]]])
+		blocks[i] = block.WithSeal(header)
+
+		if _, err := chain.InsertChain(blocks[i : i+1]); err != nil {
+			t.Fatalf("failed to import block %d: %v", i, err)
+		}
+	}
+	return chain, engine
+}
+
 // testerVote represents a single block signed by a particular account, where
 // the account may or may not have cast a Clique vote.
 type testerVote struct {
@@ -506,3 +574,67 @@ func (tt *cliqueTest) run(t *testing.T) {
 		}
 	}
 }
+
+// Tests that a signer missing consecutive in-turn slots is suspended from the
+// in-turn rotation since the fork and re-admitted when it seals again.
+func TestSignerSuspension(t *testing.T) {
+	// The first signer (in ascending order) is offline until block 10, blocks
+	// 4 and 5 are sealed out-of-turn since in-turn signers have signed recently
+	sealers := []int{1, 2, 1, 2, 1, 2, 1, 2, 1, 0}
+
+	tests := []struct {
+		config     *params.CliqueConfig
+		difficulty []*big.Int
+		suspended  map[uint64]bool // Whether the first signer is suspended after the block
+		missed     map[uint64]uint64
+	}{
+		{
+			// No fork, in-turn slots of the offline signer are sealed out-of-turn
+			config:     &params.CliqueConfig{Period: 1, Epoch: 30000},
+			difficulty: []*big.Int{diffInTurn, diffInTurn, diffNoTurn, diffNoTurn, diffNoTurn, diffNoTurn, diffInTurn, diffInTurn, diffNoTurn, diffNoTurn},
+			suspended:  map[uint64]bool{5: false, 6: false, 9: false},
+		},
+		{
+			// Offline signer is suspended after missing 2 slots, the remaining
+			// signers seal in-turn until it comes back at block 10
+			config:     &params.CliqueConfig{Period: 1, Epoch: 30000, SuspensionBlock: big.NewInt(1), SuspensionThreshold: 2},
+			difficulty: []*big.Int{diffInTurn, diffInTurn, diffNoTurn, diffNoTurn, diffNoTurn, diffNoTurn, diffInTurn, diffInTurn, diffInTurn, diffNoTurn},
+			suspended:  map[uint64]bool{5: false, 6: true, 9: true, 10: false},
+			missed:     map[uint64]uint64{5: 1, 6: 2, 10: 0},
+		},
+		{
+			// Suspensions are reset at checkpoint blocks
+			config:     &params.CliqueConfig{Period: 1, Epoch: 8, SuspensionBlock: big.NewInt(1), SuspensionThreshold: 2},
+			difficulty: []*big.Int{diffInTurn, diffInTurn, diffNoTurn, diffNoTurn, diffNoTurn, diffNoTurn, diffInTurn, diffInTurn, diffNoTurn, diffNoTurn},
+			suspended:  map[uint64]bool{6: true, 7: true, 8: false, 9: false},
+			missed:     map[uint64]uint64{6: 2, 8: 0, 9: 1},
+		},
+	}
+	for i, tt := range tests {
+		accounts := newTesterAccountPool()
+		chain, engine := newTesterChain(t, accounts, newTesterGenesis(tt.config), []string{"A", "B", "C"}, sealers)
+
+		signers := []common.Address{accounts.address("A"), accounts.address("B"), accounts.address("C")}
+		sort.Sort(signersAscending(signers))
+
+		for j, want := range tt.difficulty {
+			if have := chain.GetHeaderByNumber(uint64(j + 1)).Difficulty; have.Cmp(want) != 0 {
+				t.Errorf("test %d: block %d difficulty mismatch: have %v, want %v", i, j+1, have, want)
+			}
+		}
+		for number, want := range tt.suspended {
+			header := chain.GetHeaderByNumber(number)
+			snap, err := engine.snapshot(chain, number, header.Hash(), nil)
+			if err != nil {
+				t.Fatalf("test %d: failed to retrieve snapshot of block %d: %v", i, number, err)
+			}
+			if _, have := snap.Suspended[signers[0]]; have != want {
+				t.Errorf("test %d: block %d suspension mismatch: have %v, want %v", i, number, have, want)
+			}
+			if missed, ok := tt.missed[number]; ok && snap.Missed[signers[0]] != missed {
+				t.Errorf("test %d: block %d missed slots mismatch: have %d, want %d", i, number, snap.Missed[signers[0]], missed)
+			}
+		}
+		chain.Stop()
+	}
+}
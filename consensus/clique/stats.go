@@ -0,0 +1,219 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package clique
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// maxSignerStatsRange is the maximum number of blocks signer stats can be collected for at once.
+const maxSignerStatsRange = 100000
+
+// sealRecord is an entry of the on-disk seal index, which keeps details of
+// sealing a canonical block, so that signer stats can be collected without
+// recovering signatures and replaying snapshots again.
+type sealRecord struct {
+	Hash         common.Hash    // Hash of the sealed block, used to detect reorgs
+	Signer       common.Address // Signer who sealed the block
+	InTurnSigner common.Address // Signer who was in-turn at the block height
+	Delay        uint64         // Seconds passed since the parent block
+}
+
+// sealRecordKey = CliqueSealPrefix + num (uint64 big endian)
+func sealRecordKey(number uint64) []byte {
+	key := make([]byte, len(rawdb.CliqueSealPrefix)+8)
+	copy(key, rawdb.CliqueSealPrefix)
+	binary.BigEndian.PutUint64(key[len(rawdb.CliqueSealPrefix):], number)
+	return key
+}
+
+// loadSealRecord loads the seal record of the block with the given number from the database.
+func loadSealRecord(db ethdb.KeyValueReader, number uint64) (*sealRecord, error) {
+	blob, err := db.Get(sealRecordKey(number))
+	if err != nil {
+		return nil, err
+	}
+	record := new(sealRecord)
+	if err := rlp.DecodeBytes(blob, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// storeSealRecord inserts the seal record of the block with the given number into the database.
+func storeSealRecord(db ethdb.KeyValueWriter, number uint64, record *sealRecord) error {
+	blob, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		return err
+	}
+	return db.Put(sealRecordKey(number), blob)
+}
+
+// SignerStats is the sealing performance of a single signer over a range of blocks.
+type SignerStats struct {
+	InTurn           uint64  `json:"inTurn"`           // Blocks sealed in-turn
+	OutOfTurn        uint64  `json:"outOfTurn"`        // Blocks sealed out-of-turn
+	MissedInTurn     uint64  `json:"missedInTurn"`     // In-turn slots sealed by other signers
+	LongestGap       uint64  `json:"longestGap"`       // Longest run of consecutive blocks not sealed by the signer
+	AverageSealDelay float64 `json:"averageSealDelay"` // Average seconds between parent and sealed blocks beyond the period
+
+	lastSealed uint64 // Number of the last block sealed by the signer
+	totalDelay uint64 // Sum of seconds between parent and sealed blocks
+}
+
+// SignerStatsResult is the sealing performance of signers over a range of blocks.
+type SignerStatsResult struct {
+	From    uint64                          `json:"from"`
+	To      uint64                          `json:"to"`
+	Period  uint64                          `json:"period"`
+	Signers map[common.Address]*SignerStats `json:"signers"`
+}
+
+// signerStats collects the sealing performance of signers over the given range
+// of canonical blocks. Seal records missing in the index, or recorded for
+// blocks reorged out since, are derived from the parent snapshots. The index
+// is only read, it is updated as the blocks are written.
+func (c *Clique) signerStats(chain consensus.ChainHeaderReader, from, to uint64) (*SignerStatsResult, error) {
+	if from == 0 {
+		from = 1 // Genesis block is not sealed
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range %d - %d", from, to)
+	}
+	if to-from+1 > maxSignerStatsRange {
+		return nil, fmt.Errorf("block range %d - %d exceeds the limit of %d blocks", from, to, maxSignerStatsRange)
+	}
+	last := chain.GetHeaderByNumber(to)
+	if last == nil {
+		return nil, errUnknownBlock
+	}
+	snap, err := c.snapshot(chain, to, last.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	result := &SignerStatsResult{
+		From:    from,
+		To:      to,
+		Period:  c.config.Period,
+		Signers: make(map[common.Address]*SignerStats),
+	}
+	stats := func(signer common.Address) *SignerStats {
+		if _, ok := result.Signers[signer]; !ok {
+			result.Signers[signer] = &SignerStats{lastSealed: from - 1}
+		}
+		return result.Signers[signer]
+	}
+	for _, signer := range snap.signers() {
+		stats(signer)
+	}
+	snap = nil
+
+	for number := from; number <= to; number++ {
+		header := chain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, fmt.Errorf("missing block %d", number)
+		}
+		record, err := loadSealRecord(c.db, number)
+		if err != nil || record.Hash != header.Hash() {
+			if snap == nil || snap.Hash != header.ParentHash {
+				if snap, err = c.snapshot(chain, number-1, header.ParentHash, nil); err != nil {
+					return nil, err
+				}
+			}
+			parent := chain.GetHeader(header.ParentHash, number-1)
+			if parent == nil {
+				return nil, consensus.ErrUnknownAncestor
+			}
+			if record, err = c.newSealRecord(snap, parent, header); err != nil {
+				return nil, err
+			}
+			if snap, err = snap.apply([]*types.Header{header}); err != nil {
+				return nil, err
+			}
+		}
+		sealer := stats(record.Signer)
+		if record.Signer == record.InTurnSigner {
+			sealer.InTurn++
+		} else {
+			sealer.OutOfTurn++
+			stats(record.InTurnSigner).MissedInTurn++
+		}
+		if gap := number - sealer.lastSealed - 1; gap > sealer.LongestGap {
+			sealer.LongestGap = gap
+		}
+		sealer.lastSealed = number
+		sealer.totalDelay += record.Delay
+	}
+	for _, signer := range result.Signers {
+		if gap := to - signer.lastSealed; gap > signer.LongestGap {
+			signer.LongestGap = gap
+		}
+		if sealed := signer.InTurn + signer.OutOfTurn; sealed > 0 {
+			signer.AverageSealDelay = float64(signer.totalDelay)/float64(sealed) - float64(c.config.Period)
+		}
+	}
+	return result, nil
+}
+
+// newSealRecord derives the seal record of the header from its parent and the
+// snapshot at the parent.
+func (c *Clique) newSealRecord(snap *Snapshot, parent, header *types.Header) (*sealRecord, error) {
+	signer, err := ecrecover(header, c.signatures)
+	if err != nil {
+		return nil, err
+	}
+	return &sealRecord{
+		Hash:         header.Hash(),
+		Signer:       signer,
+		InTurnSigner: snap.inturnSigner(header.Number.Uint64()),
+		Delay:        header.Time - parent.Time,
+	}, nil
+}
+
+// IndexBlock inserts the seal record of a block written to the chain into the
+// seal index, using the batch the block itself is written with. Blocks not
+// ending up canonical leave records behind that are ignored when collecting
+// stats over their height.
+func (c *Clique) IndexBlock(chain consensus.ChainHeaderReader, header *types.Header, batch ethdb.KeyValueWriter) {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return
+	}
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if parent == nil {
+		return
+	}
+	snap, err := c.snapshot(chain, number-1, header.ParentHash, nil)
+	if err == nil {
+		var record *sealRecord
+		if record, err = c.newSealRecord(snap, parent, header); err == nil {
+			err = storeSealRecord(batch, number, record)
+		}
+	}
+	if err != nil {
+		log.Warn("Failed to index clique seal", "number", number, "hash", header.Hash(), "err", err)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package clique
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignerStats(t *testing.T) {
+	accounts := newTesterAccountPool()
+	chain, engine := newTesterChain(t, accounts, newTesterGenesis(&params.CliqueConfig{Period: 1, Epoch: 30000}), []string{"A", "B", "C"}, []int{1, 2, 1, 2, 0, 1})
+	defer chain.Stop()
+
+	signers := []common.Address{accounts.address("A"), accounts.address("B"), accounts.address("C")}
+	sort.Sort(signersAscending(signers))
+
+	// Blocks are generated 10 seconds apart, the period is 1 second
+	expected := map[common.Address]*SignerStats{
+		signers[0]: {InTurn: 0, OutOfTurn: 1, MissedInTurn: 2, LongestGap: 4, AverageSealDelay: 9},
+		signers[1]: {InTurn: 1, OutOfTurn: 2, MissedInTurn: 1, LongestGap: 2, AverageSealDelay: 9},
+		signers[2]: {InTurn: 1, OutOfTurn: 1, MissedInTurn: 1, LongestGap: 2, AverageSealDelay: 9},
+	}
+	api := &API{chain: chain, clique: engine}
+
+	// Blocks are indexed as they are written
+	for number := uint64(1); number <= 6; number++ {
+		record, err := loadSealRecord(engine.db, number)
+		require.NoError(t, err)
+		assert.Equal(t, chain.GetHeaderByNumber(number).Hash(), record.Hash)
+	}
+	// Stale records are ignored when collecting stats, but left intact
+	require.NoError(t, storeSealRecord(engine.db, 5, &sealRecord{}))
+
+	for i := 0; i < 2; i++ {
+		stats, err := api.GetSignerStats(0, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(1), stats.From)
+		assert.Equal(t, uint64(6), stats.To)
+		assert.Equal(t, uint64(1), stats.Period)
+		require.Len(t, stats.Signers, len(expected))
+		for signer, want := range expected {
+			have := stats.Signers[signer]
+			assert.Equal(t, want.InTurn, have.InTurn, "signer %x in-turn", signer)
+			assert.Equal(t, want.OutOfTurn, have.OutOfTurn, "signer %x out-of-turn", signer)
+			assert.Equal(t, want.MissedInTurn, have.MissedInTurn, "signer %x missed in-turn", signer)
+			assert.Equal(t, want.LongestGap, have.LongestGap, "signer %x longest gap", signer)
+			assert.Equal(t, want.AverageSealDelay, have.AverageSealDelay, "signer %x seal delay", signer)
+		}
+		record, err := loadSealRecord(engine.db, 5)
+		require.NoError(t, err)
+		assert.Equal(t, &sealRecord{}, record)
+	}
+
+	// Partial range
+	to := rpc.BlockNumber(4)
+	stats, err := api.GetSignerStats(3, &to)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), stats.Signers[signers[0]].MissedInTurn)
+	assert.Equal(t, uint64(2), stats.Signers[signers[0]].LongestGap)
+	assert.Equal(t, uint64(0), stats.Signers[signers[2]].InTurn)
+	assert.Equal(t, uint64(1), stats.Signers[signers[2]].OutOfTurn)
+
+	// Invalid ranges
+	_, err = api.GetSignerStats(5, &to)
+	assert.Error(t, err)
+	to = 7
+	_, err = api.GetSignerStats(1, &to)
+	assert.ErrorIs(t, err, errUnknownBlock)
+}
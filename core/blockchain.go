@@ -185,6 +185,8 @@ type BlockChain struct {
 	//  * nil: disable tx reindexer/deleter, but still index new blocks
 	txLookupLimit uint64
 
+	mintIndexLock sync.Mutex // Synchronizes burn tx lookup updates
+
 	hc            *HeaderChain
 	rmLogsFeed    event.Feed
 	chainFeed     event.Feed
@@ -438,6 +440,12 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, genesis *Genesis
 		bc.wg.Add(1)
 		go bc.maintainTxIndex()
 	}
+	// Start mint indexer if mint contract is configured. Similarly to the tx indexer,
+	// it is not started for chains opened without indexing (e.g. read-only CLI commands).
+	if txLookupLimit != nil && chainConfig.MintContract != nil && chainConfig.MintContract.ActivationBlock != nil {
+		bc.wg.Add(1)
+		go bc.maintainMintIndex()
+	}
 	return bc, nil
 }
 
@@ -894,10 +902,16 @@ func (bc *BlockChain) writeHeadBlock(block *types.Block) {
 	rawdb.WriteTxLookupEntriesByBlock(batch, block)
 	rawdb.WriteHeadBlockHash(batch, block.Hash())
 
+	bc.mintIndexLock.Lock()
+	mints := newMintIndexWriter(bc.db, batch)
+	mints.indexBlock(block)
+	mints.flush()
+
 	// Flush the whole batch into the disk, exit the node if failed
 	if err := batch.Write(); err != nil {
 		log.Crit("Failed to update chain indexes and markers", "err", err)
 	}
+	bc.mintIndexLock.Unlock()
 	// Update all in-memory chain markers in the last step
 	bc.hc.SetCurrentHeader(block.Header())
 
@@ -1330,6 +1344,12 @@ func (bc *BlockChain) writeKnownBlock(block *types.Block) error {
 	return nil
 }
 
+// blockIndexer is implemented by consensus engines keeping their own index of
+// the written blocks, which is updated in the same batch as the block data.
+type blockIndexer interface {
+	IndexBlock(chain consensus.ChainHeaderReader, header *types.Header, batch ethdb.KeyValueWriter)
+}
+
 // writeBlockWithState writes block, metadata and corresponding state data to the
 // database.
 func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.Receipt, state *state.StateDB) error {
@@ -1350,6 +1370,9 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	rawdb.WriteBlock(blockBatch, block)
 	rawdb.WriteReceipts(blockBatch, block.Hash(), block.NumberU64(), receipts)
 	rawdb.WritePreimages(blockBatch, state.Preimages())
+	if indexer, ok := bc.engine.(blockIndexer); ok {
+		indexer.IndexBlock(bc, block.Header(), blockBatch)
+	}
 	if err := blockBatch.Write(); err != nil {
 		log.Crit("Failed to write block into disk", "err", err)
 	}
@@ -2173,6 +2196,14 @@ func (bc *BlockChain) reorg(oldHead *types.Header, newHead *types.Block) error {
 		rawdb.DeleteTxLookupEntry(indexesBatch, tx)
 	}
 
+	// Delete burn tx lookups pointing to the dropped blocks.
+	bc.mintIndexLock.Lock()
+	mints := newMintIndexWriter(bc.db, indexesBatch)
+	for _, block := range oldChain {
+		mints.unindexBlock(block)
+	}
+	mints.flush()
+
 	// Delete all hash markers that are not part of the new canonical chain.
 	// Because the reorg function does not handle new chain head, all hash
 	// markers greater than or equal to new chain head should be deleted.
@@ -2190,6 +2221,7 @@ func (bc *BlockChain) reorg(oldHead *types.Header, newHead *types.Block) error {
 	if err := indexesBatch.Write(); err != nil {
 		log.Crit("Failed to delete useless indexes", "err", err)
 	}
+	bc.mintIndexLock.Unlock()
 
 	// Send out events for logs from the old canon chain, and 'reborn'
 	// logs from the new canon chain. The number of logs can be very
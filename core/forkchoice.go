@@ -40,6 +40,14 @@ type ChainReader interface {
 	GetTd(common.Hash, uint64) *big.Int
 }
 
+// finalityReader is implemented by chains tracking a finalized block, which
+// must never be reorged out.
+type finalityReader interface {
+	CurrentFinalBlock() *types.Header
+	GetHeader(common.Hash, uint64) *types.Header
+	GetCanonicalHash(uint64) common.Hash
+}
+
 // ForkChoice is the fork chooser based on the highest total difficulty of the
 // chain(the fork choice used in the eth1) and the external fork choice (the fork
 // choice used in the eth2). This main goal of this ForkChoice is not only for
@@ -82,6 +90,10 @@ func (f *ForkChoice) ReorgNeeded(current *types.Header, extern *types.Header) (b
 	if localTD == nil || externTd == nil {
 		return false, errors.New("missing td")
 	}
+	// Never reorg out the finalized block, whatever the difficulty
+	if f.dropsFinalized(extern) {
+		return false, nil
+	}
 	// Accept the new header as the chain head if the transition
 	// is already triggered. We assume all the headers after the
 	// transition come from the trusted consensus layer.
@@ -111,3 +123,29 @@ func (f *ForkChoice) ReorgNeeded(current *types.Header, extern *types.Header) (b
 	}
 	return reorg, nil
 }
+
+// dropsFinalized returns whether switching to the chain of the external header
+// would drop the finalized block from the canonical chain.
+func (f *ForkChoice) dropsFinalized(extern *types.Header) bool {
+	chain, ok := f.chain.(finalityReader)
+	if !ok {
+		return false
+	}
+	final := chain.CurrentFinalBlock()
+	if final == nil {
+		return false
+	}
+	finalNum := final.Number.Uint64()
+
+	// Walk back to the fork point with the canonical chain
+	for header := extern; header != nil; header = chain.GetHeader(header.ParentHash, header.Number.Uint64()-1) {
+		number := header.Number.Uint64()
+		if chain.GetCanonicalHash(number) == header.Hash() {
+			return number < finalNum
+		}
+		if number <= finalNum {
+			return true
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkChoiceFinality(t *testing.T) {
+	config := *params.TestChainConfig
+	config.CepheusBlock = big.NewInt(0)
+
+	var (
+		gspec   = &Genesis{Config: &config, BaseFee: big.NewInt(params.InitialBaseFee)}
+		engine  = ethash.NewFaker()
+		genesis = gspec.ToBlock()
+	)
+	db, blocks, _ := GenerateChainWithGenesis(gspec, engine, 4, func(i int, gen *BlockGen) {})
+
+	chain, err := NewBlockChain(rawdb.NewMemoryDatabase(), nil, gspec, nil, engine, vm.Config{}, nil, nil)
+	require.NoError(t, err)
+	defer chain.Stop()
+
+	_, err = chain.InsertChain(blocks)
+	require.NoError(t, err)
+	chain.SetFinalized(blocks[1].Header())
+
+	// Heavier forks dropping the finalized block are not adopted
+	fork := func(parent *types.Block, n int) []*types.Block {
+		blocks, _ := GenerateChain(&config, parent, engine, db, n, func(i int, gen *BlockGen) {
+			gen.SetExtra([]byte("fork"))
+		})
+		return blocks
+	}
+	_, err = chain.InsertChain(fork(blocks[0], 6))
+	require.NoError(t, err)
+	assert.Equal(t, blocks[3].Hash(), chain.CurrentBlock().Hash())
+
+	_, err = chain.InsertChain(fork(genesis, 8))
+	require.NoError(t, err)
+	assert.Equal(t, blocks[3].Hash(), chain.CurrentBlock().Hash())
+
+	// Forks keeping the finalized block are
+	above := fork(blocks[1], 4)
+	_, err = chain.InsertChain(above)
+	require.NoError(t, err)
+	assert.Equal(t, above[3].Hash(), chain.CurrentBlock().Hash())
+	assert.Equal(t, blocks[1].Hash(), chain.GetCanonicalHash(2))
+}
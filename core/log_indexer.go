@@ -0,0 +1,157 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// errLogIndexUnavailable is returned if a log index section was pruned or
+// reorganised while being read.
+var errLogIndexUnavailable = errors.New("log index section unavailable")
+
+// LogIndexer implements a core.ChainIndexer, building up an exact index of the
+// blocks containing logs of every contract address and first topic. Unlike the
+// bloom bits it yields no false positives, at the cost of a larger index.
+type LogIndexer struct {
+	size    uint64              // section size to generate the index for
+	history uint64              // number of recent sections to keep, zero to keep all
+	db      ethdb.Database      // database instance to read receipts from
+	table   ethdb.Database      // database table to write index data and metadata into
+	section uint64              // Section is the section number being processed currently
+	head    common.Hash         // Head is the hash of the last header processed
+	blocks  map[string][]uint64 // Blocks of the section containing logs of each address and topic
+}
+
+// NewLogIndexer returns a chain indexer that generates an exact address and
+// topic index of the canonical chain for fast logs filtering. If history is
+// non-zero, only the given number of recent sections is kept.
+func NewLogIndexer(db ethdb.Database, size, confirms, history uint64) *ChainIndexer {
+	table := rawdb.NewTable(db, string(rawdb.LogIndexPrefix))
+	backend := &LogIndexer{
+		size:    size,
+		history: history,
+		db:      db,
+		table:   table,
+	}
+	return NewChainIndexer(db, table, backend, size, confirms, bloomThrottling, "logindex")
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new log index section.
+func (l *LogIndexer) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	l.section, l.head, l.blocks = section, common.Hash{}, make(map[string][]uint64)
+	return nil
+}
+
+// Process implements core.ChainIndexerBackend, adding the addresses and first
+// topics of a new header's logs into the index.
+func (l *LogIndexer) Process(ctx context.Context, header *types.Header) error {
+	number, hash := header.Number.Uint64(), header.Hash()
+	l.head = hash
+
+	if header.Bloom == (types.Bloom{}) {
+		return nil
+	}
+	receipts := rawdb.ReadRawReceipts(l.db, hash, number)
+	if receipts == nil {
+		return fmt.Errorf("missing receipts of block #%d [%x..]", number, hash[:4])
+	}
+	add := func(item []byte) {
+		// Blocks are processed in order, only the last one can be a duplicate
+		blocks := l.blocks[string(item)]
+		if len(blocks) == 0 || blocks[len(blocks)-1] != number {
+			l.blocks[string(item)] = append(blocks, number)
+		}
+	}
+	for _, receipt := range receipts {
+		for _, log := range receipt.Logs {
+			add(log.Address.Bytes())
+			if len(log.Topics) > 0 {
+				add(log.Topics[0].Bytes())
+			}
+		}
+	}
+	return nil
+}
+
+// Commit implements core.ChainIndexerBackend, replacing any previous index of
+// the section and pruning the sections beyond the history.
+func (l *LogIndexer) Commit() error {
+	batch := l.table.NewBatch()
+	rawdb.DeleteLogIndexSections(l.table, batch, l.section, l.section+1)
+	for item, blocks := range l.blocks {
+		rawdb.WriteLogIndexBlocks(batch, l.section, l.head, []byte(item), blocks)
+	}
+	if l.history > 0 && l.section >= l.history {
+		l.prune(batch, l.section-l.history)
+	}
+	return batch.Write()
+}
+
+// Prune implements core.ChainIndexerBackend, deleting the sections up to and
+// including the threshold.
+func (l *LogIndexer) Prune(threshold uint64) error {
+	batch := l.table.NewBatch()
+	l.prune(batch, threshold)
+	return batch.Write()
+}
+
+// prune deletes the sections up to and including the threshold into the batch.
+func (l *LogIndexer) prune(batch ethdb.Batch, threshold uint64) {
+	tail := rawdb.ReadLogIndexTail(l.table)
+	if threshold < tail {
+		return
+	}
+	rawdb.DeleteLogIndexSections(l.table, batch, tail, threshold+1)
+	rawdb.WriteLogIndexTail(batch, threshold+1)
+}
+
+// LogIndexSections returns the range [first, last) of the sections available in
+// the log index maintained by the given indexer.
+func LogIndexSections(db ethdb.Database, indexer *ChainIndexer) (uint64, uint64) {
+	sections, _, _ := indexer.Sections()
+	first := rawdb.ReadLogIndexTail(rawdb.NewTable(db, string(rawdb.LogIndexPrefix)))
+	if first > sections {
+		first = sections
+	}
+	return first, sections
+}
+
+// ReadLogIndex retrieves the numbers of the blocks in a section of the log index
+// maintained by the given indexer containing logs of the address or topic.
+func ReadLogIndex(db ethdb.Database, indexer *ChainIndexer, section uint64, item []byte) ([]uint64, error) {
+	table := rawdb.NewTable(db, string(rawdb.LogIndexPrefix))
+
+	head := indexer.SectionHead(section)
+	if head == (common.Hash{}) || section < rawdb.ReadLogIndexTail(table) {
+		return nil, errLogIndexUnavailable
+	}
+	blocks := rawdb.ReadLogIndexBlocks(table, section, head, item)
+
+	// Make sure the section wasn't replaced meanwhile
+	if indexer.SectionHead(section) != head || section < rawdb.ReadLogIndexTail(table) {
+		return nil, errLogIndexUnavailable
+	}
+	return blocks, nil
+}
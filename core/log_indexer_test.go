@@ -0,0 +1,117 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogIndexer(t *testing.T) {
+	config := *params.TestChainConfig
+	config.CepheusBlock = big.NewInt(0)
+
+	var (
+		db     = rawdb.NewMemoryDatabase()
+		gspec  = &Genesis{Config: &config, BaseFee: big.NewInt(params.InitialBaseFee)}
+		addrA  = common.HexToAddress("0x0a")
+		addrB  = common.HexToAddress("0x0b")
+		topicA = common.HexToHash("0x01")
+		topicB = common.HexToHash("0x02")
+	)
+	// Block n+1 contains a log of address A with topic A if n is even, and two
+	// logs of address B with topic B if n is a multiple of three
+	_, blocks, receipts := GenerateChainWithGenesis(gspec, ethash.NewFaker(), 8, func(i int, gen *BlockGen) {
+		receipt := types.NewReceipt(nil, false, 0)
+		if i%2 == 0 {
+			receipt.Logs = append(receipt.Logs, &types.Log{Address: addrA, Topics: []common.Hash{topicA, topicB}})
+		}
+		if i%3 == 0 {
+			receipt.Logs = append(receipt.Logs, &types.Log{Address: addrB, Topics: []common.Hash{topicB}}, &types.Log{Address: addrB, Topics: []common.Hash{topicB}})
+		}
+		if len(receipt.Logs) == 0 {
+			return
+		}
+		receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+		gen.AddUncheckedReceipt(receipt)
+		gen.AddUncheckedTx(types.NewTransaction(uint64(i), common.HexToAddress("0x1"), big.NewInt(1), 1, gen.BaseFee(), nil))
+	})
+	gspec.MustCommit(db)
+	headers := []*types.Header{gspec.ToBlock().Header()}
+	for i, block := range blocks {
+		rawdb.WriteBlock(db, block)
+		rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), receipts[i])
+		headers = append(headers, block.Header())
+	}
+	indexer := &LogIndexer{size: 3, history: 2, db: db, table: rawdb.NewTable(db, string(rawdb.LogIndexPrefix))}
+	process := func(section uint64) common.Hash {
+		require.NoError(t, indexer.Reset(context.Background(), section, common.Hash{}))
+		for _, header := range headers[section*3 : section*3+3] {
+			require.NoError(t, indexer.Process(context.Background(), header))
+		}
+		require.NoError(t, indexer.Commit())
+		return indexer.head
+	}
+	read := func(section uint64, head common.Hash, item []byte) []uint64 {
+		return rawdb.ReadLogIndexBlocks(indexer.table, section, head, item)
+	}
+	head0, head1 := process(0), process(1)
+
+	// Addresses and first topics are indexed once per block
+	assert.Equal(t, []uint64{1}, read(0, head0, addrA.Bytes()))
+	assert.Equal(t, []uint64{1}, read(0, head0, addrB.Bytes()))
+	assert.Equal(t, []uint64{3, 5}, read(1, head1, addrA.Bytes()))
+	assert.Equal(t, []uint64{4}, read(1, head1, addrB.Bytes()))
+	assert.Equal(t, []uint64{3, 5}, read(1, head1, topicA.Bytes()))
+	assert.Equal(t, []uint64{4}, read(1, head1, topicB.Bytes()))
+
+	// Reprocessing a section replaces its previous index
+	require.NoError(t, indexer.Reset(context.Background(), 1, head0))
+	for _, header := range headers[3:5] {
+		require.NoError(t, indexer.Process(context.Background(), header))
+	}
+	require.NoError(t, indexer.Commit())
+	assert.Nil(t, read(1, head1, addrA.Bytes()))
+	assert.Equal(t, []uint64{3}, read(1, indexer.head, addrA.Bytes()))
+	head1 = process(1)
+
+	// Sections beyond the history are pruned
+	head2 := process(2)
+	assert.Equal(t, uint64(1), rawdb.ReadLogIndexTail(indexer.table))
+	assert.Nil(t, read(0, head0, addrA.Bytes()))
+	assert.Equal(t, []uint64{3, 5}, read(1, head1, addrA.Bytes()))
+	assert.Equal(t, []uint64{7}, read(2, head2, addrA.Bytes()))
+
+	require.NoError(t, indexer.Prune(1))
+	assert.Equal(t, uint64(2), rawdb.ReadLogIndexTail(indexer.table))
+	assert.Nil(t, read(1, head1, addrA.Bytes()))
+	assert.Equal(t, []uint64{7}, read(2, head2, addrA.Bytes()))
+
+	// Missing receipts of blocks with logs fail the section
+	rawdb.DeleteReceipts(db, headers[1].Hash(), 1)
+	require.NoError(t, indexer.Reset(context.Background(), 0, common.Hash{}))
+	assert.Error(t, indexer.Process(context.Background(), headers[1]))
+}
@@ -28,6 +28,11 @@ var EventAbi, _ = abi.JSON(strings.NewReader(eventAbiString))
 type storageLayout struct {
 	Owner     common.Hash
 	MintLimit common.Hash
+
+	// UsedBurnTxs is a base slot of a virtual mapping(uint8 => mapping(bytes32 => bool)),
+	// which tracks burn transactions that have already been minted (see BurnTxSlot).
+	// MintState contract does not access it, it is maintained at the protocol level only.
+	UsedBurnTxs common.Hash
 }
 
 // Contract is an object representing predefined mint contract params.
@@ -44,7 +49,8 @@ var Contract = struct {
 	Bytecode:     common.Hex2Bytes(contractBytecode),
 	BytecodeHash: common.HexToHash(contractBytecodeHash),
 	StorageLayout: storageLayout{
-		Owner:     common.Hash{},
-		MintLimit: common.BytesToHash([]byte{1}),
+		Owner:       common.Hash{},
+		MintLimit:   common.BytesToHash([]byte{1}),
+		UsedBurnTxs: common.BytesToHash([]byte{2}),
 	},
 }
@@ -0,0 +1,18 @@
+package mint
+
+import "errors"
+
+// List of mint instruction execution errors, shared by the EVM and the transaction pool.
+var (
+	// ErrInvalidBurnNetwork is returned if the burn tx network byte is not a known network.
+	ErrInvalidBurnNetwork = errors.New("invalid burn tx network in mint instruction")
+
+	// ErrSenderNotAllowed is returned if the transaction sender is not the mint contract owner.
+	ErrSenderNotAllowed = errors.New("transaction sender is not allowed to mint")
+
+	// ErrLimitExceeded is returned if the mint amount exceeds the remaining mint limit.
+	ErrLimitExceeded = errors.New("mint amount exceeds mint limit")
+
+	// ErrBurnTxUsed is returned if the burn tx has already been minted.
+	ErrBurnTxUsed = errors.New("burn tx has already been minted")
+)
@@ -0,0 +1,47 @@
+package mint
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var errNotMintLog = errors.New("log is not a mint event")
+
+// Event represents decoded Mint event data.
+type Event struct {
+	Amount        *big.Int
+	BurnTxHash    common.Hash
+	BurnTxNetwork byte
+}
+
+// EventID returns the topic identifying Mint events.
+func EventID() common.Hash {
+	return EventAbi.Events[EventName].ID
+}
+
+// PackEvent packs mint event fields into log data.
+func PackEvent(amount *big.Int, burnTxHash common.Hash, burnTxNetwork byte) ([]byte, error) {
+	return EventAbi.Events[EventName].Inputs.Pack(amount, burnTxHash, burnTxNetwork)
+}
+
+// UnpackEvent decodes Mint event data from the specified log.
+// Only logs produced by mint contract address with a Mint event topic are accepted.
+func UnpackEvent(log *types.Log) (*Event, error) {
+	if log.Address != Contract.Address || len(log.Topics) == 0 || log.Topics[0] != EventID() {
+		return nil, errNotMintLog
+	}
+
+	values, err := EventAbi.Events[EventName].Inputs.Unpack(log.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		Amount:        values[0].(*big.Int),
+		BurnTxHash:    common.Hash(values[1].([32]byte)),
+		BurnTxNetwork: values[2].(uint8),
+	}, nil
+}
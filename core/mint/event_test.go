@@ -0,0 +1,45 @@
+package mint
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackAndUnpackEvent(t *testing.T) {
+	amount := big.NewInt(1000000000000000000)
+	burnTxHash := common.HexToHash("0x1234")
+
+	data, err := PackEvent(amount, burnTxHash, BurnNetworkTron)
+	assert.NoError(t, err)
+
+	event, err := UnpackEvent(&types.Log{
+		Address: Contract.Address,
+		Topics:  []common.Hash{EventID()},
+		Data:    data,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, amount, event.Amount)
+	assert.Equal(t, burnTxHash, event.BurnTxHash)
+	assert.Equal(t, BurnNetworkTron, event.BurnTxNetwork)
+}
+
+func TestUnpackForeignLog(t *testing.T) {
+	data, _ := PackEvent(big.NewInt(1), common.Hash{}, BurnNetworkEthereum)
+
+	_, err := UnpackEvent(&types.Log{
+		Address: common.HexToAddress("0x1001"),
+		Topics:  []common.Hash{EventID()},
+		Data:    data,
+	})
+	assert.ErrorIs(t, err, errNotMintLog)
+
+	_, err = UnpackEvent(&types.Log{
+		Address: Contract.Address,
+		Data:    data,
+	})
+	assert.ErrorIs(t, err, errNotMintLog)
+}
@@ -0,0 +1,30 @@
+package mint
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// InstructionSize is the exact length of mint instruction input data.
+const InstructionSize = 65
+
+// Instruction represents decoded mint instruction input data.
+type Instruction struct {
+	Amount        *big.Int
+	BurnTxHash    common.Hash
+	BurnTxNetwork byte
+}
+
+// DecodeInstruction decodes mint instruction input data.
+// Input data structure: 32 bytes - mint amount, 32 bytes - burn tx hash, 1 byte - burn tx network.
+func DecodeInstruction(data []byte) (*Instruction, bool) {
+	if len(data) != InstructionSize {
+		return nil, false
+	}
+	return &Instruction{
+		Amount:        new(big.Int).SetBytes(data[:32]),
+		BurnTxHash:    common.BytesToHash(data[32:64]),
+		BurnTxNetwork: data[64],
+	}, true
+}
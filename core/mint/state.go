@@ -0,0 +1,46 @@
+package mint
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// StateReader is a minimal state accessor required for reading mint contract state.
+type StateReader interface {
+	GetCodeHash(common.Address) common.Hash
+	GetState(common.Address, common.Hash) common.Hash
+}
+
+// State represents mint contract state.
+type State struct {
+	Owner common.Address
+	Limit *big.Int
+}
+
+// IsDeployed checks whether mint contract with a predefined bytecode is present in the specified state.
+func IsDeployed(stateDB StateReader) bool {
+	return stateDB.GetCodeHash(Contract.Address) == Contract.BytecodeHash
+}
+
+// ReadState reads mint contract owner and remaining mint limit from the specified state.
+func ReadState(stateDB StateReader) *State {
+	return &State{
+		Owner: common.BytesToAddress(stateDB.GetState(Contract.Address, Contract.StorageLayout.Owner).Bytes()),
+		Limit: stateDB.GetState(Contract.Address, Contract.StorageLayout.MintLimit).Big(),
+	}
+}
+
+// BurnTxSlot returns mint contract storage slot marking the specified burn tx as minted.
+// Slot is calculated in the same way as Solidity does for usedBurnTxs[burnTxNetwork][burnTxHash].
+func BurnTxSlot(burnTxNetwork byte, burnTxHash common.Hash) common.Hash {
+	networkSlot := crypto.Keccak256Hash(common.BytesToHash([]byte{burnTxNetwork}).Bytes(), Contract.StorageLayout.UsedBurnTxs.Bytes())
+	return crypto.Keccak256Hash(burnTxHash.Bytes(), networkSlot.Bytes())
+}
+
+// IsBurnTxUsed checks whether the specified burn tx has already been minted.
+// Only mints executed after unique burn tx fork are tracked.
+func IsBurnTxUsed(stateDB StateReader, burnTxNetwork byte, burnTxHash common.Hash) bool {
+	return stateDB.GetState(Contract.Address, BurnTxSlot(burnTxNetwork, burnTxHash)) != (common.Hash{})
+}
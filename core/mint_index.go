@@ -0,0 +1,278 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/mint"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// burnTxRef identifies a burn transaction in its original network.
+type burnTxRef struct {
+	network byte
+	hash    common.Hash
+}
+
+// mintInstructionBurnTx extracts burn tx reference from a transaction that looks like a mint instruction.
+// Whether the instruction has been actually executed should be checked against its receipt.
+func mintInstructionBurnTx(tx *types.Transaction) (burnTxRef, bool) {
+	data := tx.Data()
+	if tx.To() == nil || *tx.To() != mint.Contract.Address || len(data) != 65 {
+		return burnTxRef{}, false
+	}
+	return burnTxRef{network: data[64], hash: common.BytesToHash(data[32:64])}, true
+}
+
+// mintIndexWriter accumulates burn tx lookup changes, so several mints referring
+// to the same burn tx are not lost while written within a single batch.
+type mintIndexWriter struct {
+	db      ethdb.Reader
+	batch   ethdb.KeyValueWriter
+	entries map[burnTxRef][]rawdb.MintLookupEntry
+}
+
+func newMintIndexWriter(db ethdb.Reader, batch ethdb.KeyValueWriter) *mintIndexWriter {
+	return &mintIndexWriter{
+		db:      db,
+		batch:   batch,
+		entries: make(map[burnTxRef][]rawdb.MintLookupEntry),
+	}
+}
+
+func (w *mintIndexWriter) load(ref burnTxRef) []rawdb.MintLookupEntry {
+	if entries, exists := w.entries[ref]; exists {
+		return entries
+	}
+	return rawdb.ReadMintLookupEntries(w.db, ref.network, ref.hash)
+}
+
+// indexBlock adds lookup entries for all mints successfully executed in the specified block.
+func (w *mintIndexWriter) indexBlock(block *types.Block) {
+	txs := block.Transactions()
+
+	hasMints := false
+	for _, tx := range txs {
+		if _, ok := mintInstructionBurnTx(tx); ok {
+			hasMints = true
+			break
+		}
+	}
+	if !hasMints {
+		return
+	}
+
+	receipts := rawdb.ReadRawReceipts(w.db, block.Hash(), block.NumberU64())
+	if len(receipts) != len(txs) {
+		log.Warn("Missing receipts for mint indexing", "number", block.NumberU64(), "hash", block.Hash())
+		return
+	}
+
+	for i, receipt := range receipts {
+		for _, l := range receipt.Logs {
+			event, err := mint.UnpackEvent(l)
+			if err != nil {
+				continue
+			}
+			w.add(burnTxRef{network: event.BurnTxNetwork, hash: event.BurnTxHash}, rawdb.MintLookupEntry{
+				BlockHash:   block.Hash(),
+				BlockNumber: block.NumberU64(),
+				TxHash:      txs[i].Hash(),
+				TxIndex:     uint64(i),
+			})
+		}
+	}
+}
+
+// unindexBlock removes lookup entries pointing to the specified block.
+func (w *mintIndexWriter) unindexBlock(block *types.Block) {
+	for _, tx := range block.Transactions() {
+		ref, ok := mintInstructionBurnTx(tx)
+		if !ok {
+			continue
+		}
+		existing := w.load(ref)
+		entries := make([]rawdb.MintLookupEntry, 0, len(existing))
+		for _, entry := range existing {
+			if entry.BlockHash != block.Hash() {
+				entries = append(entries, entry)
+			}
+		}
+		w.entries[ref] = entries
+	}
+}
+
+func (w *mintIndexWriter) add(ref burnTxRef, entry rawdb.MintLookupEntry) {
+	entries := w.load(ref)
+	for _, existing := range entries {
+		if existing.BlockHash == entry.BlockHash && existing.TxHash == entry.TxHash {
+			return
+		}
+	}
+	if len(entries) > 0 {
+		log.Warn("Duplicate mint detected", "network", ref.network, "burnTx", ref.hash,
+			"tx", entry.TxHash, "number", entry.BlockNumber, "previousTx", entries[0].TxHash, "previousNumber", entries[0].BlockNumber)
+	}
+	w.entries[ref] = append(entries, entry)
+}
+
+// flush writes accumulated changes into the batch.
+func (w *mintIndexWriter) flush() {
+	for ref, entries := range w.entries {
+		if len(entries) == 0 {
+			rawdb.DeleteMintLookupEntries(w.batch, ref.network, ref.hash)
+		} else {
+			rawdb.WriteMintLookupEntries(w.batch, ref.network, ref.hash, entries)
+		}
+	}
+	w.entries = make(map[burnTxRef][]rawdb.MintLookupEntry)
+}
+
+// maintainMintIndex backfills burn tx lookup entries for canonical blocks which have been
+// imported before the index was introduced or without being set as a head block (e.g. snap sync).
+// Blocks imported afterwards are indexed as soon as they become canonical.
+func (bc *BlockChain) maintainMintIndex() {
+	defer bc.wg.Done()
+
+	from := bc.chainConfig.MintContract.ActivationBlock.Uint64()
+	if head := rawdb.ReadMintIndexHead(bc.db); head != nil && *head >= from {
+		from = *head + 1
+	}
+	to := bc.CurrentBlock().Number.Uint64()
+	if from > to {
+		return
+	}
+
+	var (
+		start  = time.Now()
+		logged = time.Now()
+		batch  = bc.db.NewBatch()
+		writer = newMintIndexWriter(bc.db, batch)
+	)
+	commit := func(number uint64) {
+		writer.flush()
+		rawdb.WriteMintIndexHead(batch, number)
+		if err := batch.Write(); err != nil {
+			log.Crit("Failed to write mint index", "err", err)
+		}
+		batch.Reset()
+	}
+
+	bc.mintIndexLock.Lock()
+	defer bc.mintIndexLock.Unlock()
+
+	for number := from; number <= to; number++ {
+		select {
+		case <-bc.quit:
+			if number > from {
+				commit(number - 1)
+			}
+			log.Info("Mint indexing interrupted", "indexed", number-from, "elapsed", common.PrettyDuration(time.Since(start)))
+			return
+		default:
+		}
+
+		hash := rawdb.ReadCanonicalHash(bc.db, number)
+		if block := rawdb.ReadBlock(bc.db, hash, number); block != nil {
+			writer.indexBlock(block)
+		}
+		if number%1024 == 0 {
+			commit(number)
+
+			// Release the lock periodically, so block imports are not stalled
+			bc.mintIndexLock.Unlock()
+			bc.mintIndexLock.Lock()
+		}
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Indexing mints", "number", number, "to", to, "elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	commit(to)
+	log.Info("Indexed mints", "from", from, "to", to, "elapsed", common.PrettyDuration(time.Since(start)))
+}
+
+// UsedBurnTxsMigration builds a state migration marking burn txs minted by canonical
+// blocks before the unique burn tx fork as used, since only mints executed after the
+// fork mark them in the state. It must be declared in chain config at the fork block,
+// otherwise burn txs minted before the fork can be minted once again.
+// Burn txs are collected from the mint index, blocks not covered by it are scanned directly.
+func UsedBurnTxsMigration(db ethdb.Database, config *params.ChainConfig) (*params.StateMigrationConfig, error) {
+	if config.MintContract == nil || config.MintContract.ActivationBlock == nil || config.MintContract.UniqueBurnTxBlock == nil {
+		return nil, errors.New("unique burn tx fork is not scheduled")
+	}
+	var (
+		from = config.MintContract.ActivationBlock.Uint64()
+		fork = config.MintContract.UniqueBurnTxBlock.Uint64()
+		used = make(map[burnTxRef]struct{})
+	)
+	if head := rawdb.ReadHeadBlock(db); head == nil || head.NumberU64()+1 < fork {
+		return nil, fmt.Errorf("chain is not synced up to the unique burn tx fork block %d", fork)
+	}
+	err := rawdb.IterateMintLookupEntries(db, func(network byte, hash common.Hash, entries []rawdb.MintLookupEntry) {
+		for _, entry := range entries {
+			if entry.BlockNumber < fork && rawdb.ReadCanonicalHash(db, entry.BlockNumber) == entry.BlockHash {
+				used[burnTxRef{network: network, hash: hash}] = struct{}{}
+				return
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if head := rawdb.ReadMintIndexHead(db); head != nil && *head >= from {
+		from = *head + 1
+	}
+	for number := from; number < fork; number++ {
+		hash := rawdb.ReadCanonicalHash(db, number)
+		block := rawdb.ReadBlock(db, hash, number)
+		if block == nil {
+			return nil, fmt.Errorf("block %d is not found", number)
+		}
+		if len(block.Transactions()) == 0 {
+			continue
+		}
+		if receipts := rawdb.ReadRawReceipts(db, hash, number); len(receipts) != len(block.Transactions()) {
+			return nil, fmt.Errorf("receipts of block %d are not found", number)
+		}
+		writer := newMintIndexWriter(db, nil)
+		writer.indexBlock(block)
+		for ref := range writer.entries {
+			used[ref] = struct{}{}
+		}
+	}
+	storage := make(map[common.Hash]common.Hash, len(used))
+	for ref := range used {
+		storage[mint.BurnTxSlot(ref.network, ref.hash)] = common.BytesToHash([]byte{1})
+	}
+	return &params.StateMigrationConfig{
+		Name:  "UsedBurnTxs",
+		Block: new(big.Int).SetUint64(fork),
+		Accounts: map[common.Address]*params.StateMigrationAccount{
+			mint.Contract.Address: {Storage: storage},
+		},
+	}, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	cmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/mint"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMintIndex(t *testing.T) {
+	config := *params.TestChainConfig
+	config.CepheusBlock = big.NewInt(0)
+	config.MintContract = &params.MintContractConfig{
+		ActivationBlock: big.NewInt(0),
+		OwnerAddress:    ownerAddr,
+		MintLimit:       (*cmath.HexOrDecimal256)(mintLimit),
+	}
+
+	var (
+		gspec = &Genesis{
+			Config: &config,
+			Alloc: GenesisAlloc{
+				ownerAddr: {Balance: big.NewInt(params.Ether)},
+				mint.Contract.Address: {
+					Balance: new(big.Int),
+					Code:    mint.Contract.Bytecode,
+					Storage: map[common.Hash]common.Hash{
+						mint.Contract.StorageLayout.Owner:     ownerAddr.Hash(),
+						mint.Contract.StorageLayout.MintLimit: common.BigToHash(mintLimit),
+					},
+				},
+			},
+			BaseFee: big.NewInt(params.InitialBaseFee),
+		}
+		signer      = types.LatestSigner(gspec.Config)
+		burnTxHashA = common.HexToHash("0x0a")
+		burnTxHashB = common.HexToHash("0x0b")
+	)
+
+	mintData := func(burnTxHash common.Hash, network byte) []byte {
+		return bytes.Join([][]byte{common.BigToHash(big.NewInt(params.Ether)).Bytes(), burnTxHash.Bytes(), {network}}, []byte{})
+	}
+
+	_, blocks, _ := GenerateChainWithGenesis(gspec, ethash.NewFaker(), 3, func(i int, block *BlockGen) {
+		var data []byte
+		switch i {
+		case 0, 1:
+			data = mintData(burnTxHashA, mint.BurnNetworkEthereum)
+		case 2:
+			data = mintData(burnTxHashB, mint.BurnNetworkTron)
+		}
+		tx, err := types.SignTx(types.NewTransaction(block.TxNonce(ownerAddr), mint.Contract.Address, new(big.Int), 130000, block.header.BaseFee, data), signer, ownerKey)
+		if err != nil {
+			panic(err)
+		}
+		block.AddTx(tx)
+	})
+
+	chain, err := NewBlockChain(rawdb.NewMemoryDatabase(), nil, gspec, nil, ethash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+
+	check := func() {
+		entries := rawdb.ReadMintLookupEntries(chain.db, mint.BurnNetworkEthereum, burnTxHashA)
+		assert.Len(t, entries, 2)
+		assert.Equal(t, rawdb.MintLookupEntry{
+			BlockHash:   blocks[0].Hash(),
+			BlockNumber: 1,
+			TxHash:      blocks[0].Transactions()[0].Hash(),
+		}, entries[0])
+		assert.Equal(t, blocks[1].Transactions()[0].Hash(), entries[1].TxHash)
+
+		entries = rawdb.ReadMintLookupEntries(chain.db, mint.BurnNetworkTron, burnTxHashB)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, blocks[2].Transactions()[0].Hash(), entries[0].TxHash)
+
+		assert.Len(t, rawdb.ReadMintLookupEntries(chain.db, mint.BurnNetworkTron, burnTxHashA), 0)
+	}
+	check()
+
+	// Unindexing a block drops entries pointing to it only
+	batch := chain.db.NewBatch()
+	writer := newMintIndexWriter(chain.db, batch)
+	writer.unindexBlock(blocks[1])
+	writer.flush()
+	assert.NoError(t, batch.Write())
+
+	entries := rawdb.ReadMintLookupEntries(chain.db, mint.BurnNetworkEthereum, burnTxHashA)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, blocks[0].Hash(), entries[0].BlockHash)
+
+	// Drop the whole index and ensure it is backfilled
+	rawdb.DeleteMintLookupEntries(chain.db, mint.BurnNetworkEthereum, burnTxHashA)
+	rawdb.DeleteMintLookupEntries(chain.db, mint.BurnNetworkTron, burnTxHashB)
+	rawdb.WriteMintIndexHead(chain.db, 0)
+
+	chain.wg.Add(1)
+	chain.maintainMintIndex()
+
+	check()
+	assert.Equal(t, uint64(3), *rawdb.ReadMintIndexHead(chain.db))
+
+	// Burn txs minted before the unique burn tx fork are collected for seeding,
+	// both from the index and from the blocks not covered by it
+	config.MintContract.UniqueBurnTxBlock = big.NewInt(3)
+	rawdb.DeleteMintLookupEntries(chain.db, mint.BurnNetworkEthereum, burnTxHashA)
+	rawdb.WriteMintIndexHead(chain.db, 1)
+
+	migration, err := UsedBurnTxsMigration(chain.db, &config)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(3), migration.Block)
+	assert.Equal(t, map[common.Hash]common.Hash{
+		mint.BurnTxSlot(mint.BurnNetworkEthereum, burnTxHashA): common.BytesToHash([]byte{1}),
+	}, migration.Accounts[mint.Contract.Address].Storage)
+
+	config.MintContract.UniqueBurnTxBlock = big.NewInt(4)
+	migration, err = UsedBurnTxsMigration(chain.db, &config)
+	assert.NoError(t, err)
+	assert.Len(t, migration.Accounts[mint.Contract.Address].Storage, 2)
+	assert.Contains(t, migration.Accounts[mint.Contract.Address].Storage, mint.BurnTxSlot(mint.BurnNetworkTron, burnTxHashB))
+
+	config.MintContract.UniqueBurnTxBlock = big.NewInt(5)
+	_, err = UsedBurnTxsMigration(chain.db, &config)
+	assert.Error(t, err)
+}
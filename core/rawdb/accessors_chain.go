@@ -232,6 +232,24 @@ func WriteFinalizedBlockHash(db ethdb.KeyValueWriter, hash common.Hash) {
 	}
 }
 
+// ReadLastAttestation retrieves the number and hash of the highest block attested
+// by the local clique signer, if any.
+func ReadLastAttestation(db ethdb.KeyValueReader) (uint64, common.Hash, bool) {
+	data, _ := db.Get(lastAttestationKey)
+	if len(data) != 8+common.HashLength {
+		return 0, common.Hash{}, false
+	}
+	return binary.BigEndian.Uint64(data[:8]), common.BytesToHash(data[8:]), true
+}
+
+// WriteLastAttestation stores the number and hash of the highest block attested
+// by the local clique signer.
+func WriteLastAttestation(db ethdb.KeyValueWriter, number uint64, hash common.Hash) {
+	if err := db.Put(lastAttestationKey, append(encodeBlockNumber(number), hash.Bytes()...)); err != nil {
+		log.Crit("Failed to store last attestation", "err", err)
+	}
+}
+
 // ReadLastPivotNumber retrieves the number of the last pivot block. If the node
 // full synced, the last pivot will always be nil.
 func ReadLastPivotNumber(db ethdb.KeyValueReader) *uint64 {
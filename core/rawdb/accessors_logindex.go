@@ -0,0 +1,98 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ReadLogIndexBlocks retrieves the numbers of the blocks in the given section
+// containing logs of the address or topic, in ascending order.
+func ReadLogIndexBlocks(db ethdb.KeyValueReader, section uint64, head common.Hash, item []byte) []uint64 {
+	data, _ := db.Get(logIndexKey(section, head, item))
+	var (
+		blocks []uint64
+		number uint64
+	)
+	for len(data) > 0 {
+		delta, n := binary.Uvarint(data)
+		if n <= 0 {
+			log.Error("Invalid log index entry", "section", section, "item", common.Bytes2Hex(item))
+			return nil
+		}
+		number += delta
+		blocks = append(blocks, number)
+		data = data[n:]
+	}
+	return blocks
+}
+
+// WriteLogIndexBlocks stores the numbers of the blocks in the given section
+// containing logs of the address or topic. The numbers must be ascending.
+func WriteLogIndexBlocks(db ethdb.KeyValueWriter, section uint64, head common.Hash, item []byte, blocks []uint64) {
+	var (
+		data = make([]byte, 0, 2*len(blocks))
+		prev uint64
+	)
+	for _, number := range blocks {
+		data = binary.AppendUvarint(data, number-prev)
+		prev = number
+	}
+	if err := db.Put(logIndexKey(section, head, item), data); err != nil {
+		log.Crit("Failed to store log index entry", "err", err)
+	}
+}
+
+// DeleteLogIndexSections removes the log index entries of the sections in the
+// range [from, to), whatever their section head.
+func DeleteLogIndexSections(db ethdb.Iteratee, batch ethdb.KeyValueWriter, from, to uint64) {
+	start, end := logIndexKey(from, common.Hash{}, nil), logIndexKey(to, common.Hash{}, nil)
+	it := db.NewIterator(logIndexPrefix, start[len(logIndexPrefix):])
+	defer it.Release()
+
+	for it.Next() {
+		if string(it.Key()) >= string(end) {
+			break
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			log.Crit("Failed to delete log index entry", "err", err)
+		}
+	}
+	if it.Error() != nil {
+		log.Crit("Failed to iterate log index", "err", it.Error())
+	}
+}
+
+// ReadLogIndexTail retrieves the first section not pruned from the log index.
+func ReadLogIndexTail(db ethdb.KeyValueReader) uint64 {
+	data, _ := db.Get(logIndexTailKey)
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
+
+// WriteLogIndexTail stores the first section not pruned from the log index.
+func WriteLogIndexTail(db ethdb.KeyValueWriter, section uint64) {
+	if err := db.Put(logIndexTailKey, encodeBlockNumber(section)); err != nil {
+		log.Crit("Failed to store the log index tail", "err", err)
+	}
+}
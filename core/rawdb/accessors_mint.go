@@ -0,0 +1,104 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// MintLookupEntry is a positional metadata of a mint transaction referring to a specific burn tx.
+type MintLookupEntry struct {
+	BlockHash   common.Hash
+	BlockNumber uint64
+	TxHash      common.Hash
+	TxIndex     uint64
+}
+
+// ReadMintLookupEntries retrieves all known mint transactions referring to the specified burn tx.
+// More than one entry means that the same burn tx has been minted several times.
+func ReadMintLookupEntries(db ethdb.KeyValueReader, burnTxNetwork byte, burnTxHash common.Hash) []MintLookupEntry {
+	data, _ := db.Get(mintLookupKey(burnTxNetwork, burnTxHash))
+	if len(data) == 0 {
+		return nil
+	}
+	var entries []MintLookupEntry
+	if err := rlp.DecodeBytes(data, &entries); err != nil {
+		log.Error("Invalid mint lookup entry RLP", "network", burnTxNetwork, "hash", burnTxHash, "err", err)
+		return nil
+	}
+	return entries
+}
+
+// WriteMintLookupEntries stores mint transactions positional metadata for the specified burn tx.
+func WriteMintLookupEntries(db ethdb.KeyValueWriter, burnTxNetwork byte, burnTxHash common.Hash, entries []MintLookupEntry) {
+	data, err := rlp.EncodeToBytes(entries)
+	if err != nil {
+		log.Crit("Failed to RLP encode mint lookup entries", "err", err)
+	}
+	if err := db.Put(mintLookupKey(burnTxNetwork, burnTxHash), data); err != nil {
+		log.Crit("Failed to store mint lookup entries", "err", err)
+	}
+}
+
+// DeleteMintLookupEntries removes all mint lookup entries for the specified burn tx.
+func DeleteMintLookupEntries(db ethdb.KeyValueWriter, burnTxNetwork byte, burnTxHash common.Hash) {
+	if err := db.Delete(mintLookupKey(burnTxNetwork, burnTxHash)); err != nil {
+		log.Crit("Failed to delete mint lookup entries", "err", err)
+	}
+}
+
+// IterateMintLookupEntries calls fn for every burn tx having mint lookup entries.
+func IterateMintLookupEntries(db ethdb.Iteratee, fn func(burnTxNetwork byte, burnTxHash common.Hash, entries []MintLookupEntry)) error {
+	it := db.NewIterator(mintLookupPrefix, nil)
+	defer it.Release()
+
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(mintLookupPrefix)+1+common.HashLength {
+			continue
+		}
+		var entries []MintLookupEntry
+		if err := rlp.DecodeBytes(it.Value(), &entries); err != nil {
+			return fmt.Errorf("invalid mint lookup entry RLP for burn tx %x: %w", key[len(mintLookupPrefix)+1:], err)
+		}
+		fn(key[len(mintLookupPrefix)], common.BytesToHash(key[len(mintLookupPrefix)+1:]), entries)
+	}
+	return it.Error()
+}
+
+// ReadMintIndexHead retrieves the number of the latest block indexed by the background mint indexer.
+func ReadMintIndexHead(db ethdb.KeyValueReader) *uint64 {
+	data, _ := db.Get(mintIndexHeadKey)
+	if len(data) != 8 {
+		return nil
+	}
+	number := binary.BigEndian.Uint64(data)
+	return &number
+}
+
+// WriteMintIndexHead stores the number of the latest block indexed by the background mint indexer.
+func WriteMintIndexHead(db ethdb.KeyValueWriter, number uint64) {
+	if err := db.Put(mintIndexHeadKey, encodeBlockNumber(number)); err != nil {
+		log.Crit("Failed to store the mint index head", "err", err)
+	}
+}
@@ -42,6 +42,9 @@ var (
 	// headFinalizedBlockKey tracks the latest known finalized block hash.
 	headFinalizedBlockKey = []byte("LastFinalized")
 
+	// lastAttestationKey tracks the number and hash of the highest block attested by the local clique signer.
+	lastAttestationKey = []byte("LastAttestation")
+
 	// lastPivotKey tracks the last pivot block used by fast sync (to reenable on sethead).
 	lastPivotKey = []byte("LastPivot")
 
@@ -75,6 +78,9 @@ var (
 	// fastTxLookupLimitKey tracks the transaction lookup limit during fast sync.
 	fastTxLookupLimitKey = []byte("FastTransactionLookupLimit")
 
+	// mintIndexHeadKey tracks the latest block whose mints have been indexed by the background indexer.
+	mintIndexHeadKey = []byte("MintIndexHead")
+
 	// badBlockKey tracks the list of bad blocks seen by local
 	badBlockKey = []byte("InvalidBlock")
 
@@ -111,6 +117,13 @@ var (
 	// BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
 	BloomBitsIndexPrefix = []byte("iB")
 
+	// LogIndexPrefix is the data table of the log index, holding both the progress
+	// of its chain indexer and the index itself.
+	LogIndexPrefix = []byte("iL")
+
+	logIndexPrefix  = []byte("e")            // logIndexPrefix + section (uint64 big endian) + section head + address or topic -> block numbers, within the log index table
+	logIndexTailKey = []byte("LogIndexTail") // first section not pruned from the log index, within the log index table
+
 	ChtPrefix           = []byte("chtRootV2-") // ChtPrefix + chtNum (uint64 big endian) -> trie root hash
 	ChtTablePrefix      = []byte("cht-")
 	ChtIndexTablePrefix = []byte("chtIndexV2-")
@@ -120,6 +133,10 @@ var (
 	BloomTrieIndexPrefix = []byte("bltIndex-")
 
 	CliqueSnapshotPrefix = []byte("clique-")
+	CliqueSealPrefix     = []byte("cliqueSeal-")     // CliqueSealPrefix + num (uint64 big endian) -> clique seal record
+	CliqueProposalPrefix = []byte("cliqueProposal-") // CliqueProposalPrefix + id (uint64 big endian) -> clique proposal
+
+	mintLookupPrefix = []byte("mint-burn-") // mintLookupPrefix + burn tx network + burn tx hash -> mint lookup entries
 
 	preimageCounter    = metrics.NewRegisteredCounter("db/preimage/total", nil)
 	preimageHitCounter = metrics.NewRegisteredCounter("db/preimage/hits", nil)
@@ -180,6 +197,18 @@ func txLookupKey(hash common.Hash) []byte {
 	return append(txLookupPrefix, hash.Bytes()...)
 }
 
+// logIndexKey = logIndexPrefix + section (uint64 big endian) + section head + address or topic
+func logIndexKey(section uint64, head common.Hash, item []byte) []byte {
+	key := make([]byte, 0, len(logIndexPrefix)+8+common.HashLength+len(item))
+	key = append(append(key, logIndexPrefix...), encodeBlockNumber(section)...)
+	return append(append(key, head.Bytes()...), item...)
+}
+
+// mintLookupKey = mintLookupPrefix + burn tx network + burn tx hash
+func mintLookupKey(burnTxNetwork byte, burnTxHash common.Hash) []byte {
+	return append(append(mintLookupPrefix, burnTxNetwork), burnTxHash.Bytes()...)
+}
+
 // accountSnapshotKey = SnapshotAccountPrefix + hash
 func accountSnapshotKey(hash common.Hash) []byte {
 	return append(SnapshotAccountPrefix, hash.Bytes()...)
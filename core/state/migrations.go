@@ -21,6 +21,10 @@ type Migration interface {
 	Execute(stateDB vm.StateDB)
 }
 
+// MigrationSourceProcessor is the source of the migrations executed by the block
+// processor. Replays of the migrations from other sources are logged at debug level.
+const MigrationSourceProcessor = "state processor"
+
 // Migrations represents migrations lists mapped by block heights where these migrations should be executed.
 type Migrations map[uint64][]Migration
 
@@ -28,6 +32,7 @@ type Migrations map[uint64][]Migration
 // If state should be changed in some unusual way that is not described in consensus rules,
 // it can be done by writing a new state migration and registering it here,
 // so migration will be applied at specific block and hardfork will happen.
+// Migrations declared in chain config are registered alongside the built-in ones.
 func InitMigrations(config *params.ChainConfig) Migrations {
 	output := make(Migrations)
 
@@ -37,6 +42,10 @@ func InitMigrations(config *params.ChainConfig) Migrations {
 		migrations.NewCassiopeiaMigration(config),
 	}
 
+	for _, migration := range migrations.NewConfigMigrations(config) {
+		availableMigrations = append(availableMigrations, migration)
+	}
+
 	for _, migration := range availableMigrations {
 		output.register(migration)
 	}
@@ -59,14 +68,21 @@ func (m Migrations) register(migration Migration) {
 }
 
 // Execute finds state migrations for specified height and executes each of them for specified state.
-func (m Migrations) Execute(height *big.Int, stateDB vm.StateDB, source string) {
+// Executed migrations are returned in order of execution.
+func (m Migrations) Execute(height *big.Int, stateDB vm.StateDB, source string) []Migration {
 	migrationsForBlock, exists := m[height.Uint64()]
 	if !exists {
-		return
+		return nil
 	}
 
+	logger := log.Debug
+	if source == MigrationSourceProcessor {
+		logger = log.Info
+	}
 	for _, migration := range migrationsForBlock {
-		log.Info("Executing state migration", "name", migration.Name(), "height", height.Uint64(), "source", source)
+		logger("Executing state migration", "name", migration.Name(), "height", height.Uint64(), "source", source)
 		migration.Execute(stateDB)
 	}
+
+	return migrationsForBlock
 }
@@ -33,7 +33,7 @@ func (m *CassiopeiaMigration) Block() *big.Int {
 }
 
 func (m *CassiopeiaMigration) Name() string {
-	return "cassiopeia"
+	return params.CassiopeiaMigrationName
 }
 
 func (m *CassiopeiaMigration) Execute(stateDB vm.StateDB) {
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ConfigMigration applies state changes declared in chain config,
+// so networks can schedule irregular state changes without a new node release.
+type ConfigMigration struct {
+	config *params.StateMigrationConfig
+}
+
+// NewConfigMigrations creates migration instances for all state migrations declared in chain config.
+// The declarations are validated by params.ChainConfig.CheckConfigForkOrder.
+func NewConfigMigrations(config *params.ChainConfig) []*ConfigMigration {
+	output := make([]*ConfigMigration, 0, len(config.StateMigrations))
+	for _, migration := range config.StateMigrations {
+		output = append(output, &ConfigMigration{config: migration})
+	}
+
+	return output
+}
+
+func (m *ConfigMigration) Block() *big.Int {
+	return m.config.Block
+}
+
+func (m *ConfigMigration) Name() string {
+	return m.config.Name
+}
+
+func (m *ConfigMigration) Execute(stateDB vm.StateDB) {
+	// Accounts are processed in a stable order, so the state journal does not depend on map iteration
+	addresses := make([]common.Address, 0, len(m.config.Accounts))
+	for address := range m.config.Accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) < 0
+	})
+
+	for _, address := range addresses {
+		account := m.config.Accounts[address]
+		if account == nil {
+			continue
+		}
+		if account.Code != nil {
+			stateDB.SetCode(address, account.Code)
+		}
+		for key, value := range account.Storage {
+			stateDB.SetState(address, key, value)
+		}
+		if account.Balance != nil {
+			stateDB.SubBalance(address, stateDB.GetBalance(address))
+			stateDB.AddBalance(address, (*big.Int)(account.Balance))
+		}
+		if account.Nonce != nil {
+			stateDB.SetNonce(address, uint64(*account.Nonce))
+		}
+	}
+}
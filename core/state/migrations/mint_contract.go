@@ -46,7 +46,7 @@ func (m *MintContractMigration) Block() *big.Int {
 }
 
 func (m *MintContractMigration) Name() string {
-	return "mint contract initialization"
+	return params.MintContractMigrationName
 }
 
 func (m *MintContractMigration) Execute(stateDB vm.StateDB) {
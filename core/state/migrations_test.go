@@ -1,6 +1,7 @@
 package state
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -180,3 +181,41 @@ func TestApplyCassiopeiaMigration(t *testing.T) {
 		delete(expectedSoulDropChanges, storageChangeEntry.key)
 	}
 }
+
+func TestApplyConfigMigration(t *testing.T) {
+	var chainConfig params.ChainConfig
+	err := json.Unmarshal([]byte(`{
+		"stateMigrations": [{
+			"name": "private fix",
+			"block": 500,
+			"accounts": {
+				"0x1000000000000000000000000000000000000000": {
+					"code": "0x6001",
+					"storage": {"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000002"}
+				},
+				"0x2000000000000000000000000000000000000000": {"balance": "0x64", "nonce": "0x7"}
+			}
+		}]
+	}`), &chainConfig)
+	assert.NoError(t, err)
+
+	migrationsList := InitMigrations(&chainConfig)
+	assert.Len(t, migrationsList[500], 1)
+	assert.Equal(t, "private fix", migrationsList[500][0].Name())
+
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000000")
+	account := common.HexToAddress("0x2000000000000000000000000000000000000000")
+
+	state, _ := New(common.Hash{}, NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	state.AddBalance(account, big.NewInt(1000))
+	state.SetNonce(contract, 3)
+
+	executed := migrationsList.Execute(big.NewInt(500), state, "tests")
+	assert.Len(t, executed, 1)
+
+	assert.Equal(t, []byte{0x60, 0x01}, state.GetCode(contract))
+	assert.Equal(t, common.HexToHash("0x02"), state.GetState(contract, common.HexToHash("0x01")))
+	assert.Equal(t, uint64(3), state.GetNonce(contract))
+	assert.Equal(t, big.NewInt(100), state.GetBalance(account))
+	assert.Equal(t, uint64(7), state.GetNonce(account))
+}
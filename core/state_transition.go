@@ -400,12 +400,9 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 		fee := new(big.Int).SetUint64(st.gasUsed())
 		fee.Mul(fee, effectiveTip)
 
-		feeCollectorAddress := st.evm.Context.Coinbase
-		if st.evm.ChainConfig().FeeCollectorAddress != nil {
-			feeCollectorAddress = *st.evm.ChainConfig().FeeCollectorAddress
+		for _, credit := range SplitFee(st.evm.ChainConfig(), st.evm.Context.BlockNumber, st.evm.Context.Coinbase, fee) {
+			st.state.AddBalance(credit.Recipient, credit.Amount)
 		}
-
-		st.state.AddBalance(feeCollectorAddress, fee)
 	}
 
 	return &ExecutionResult{
@@ -432,6 +429,47 @@ func (st *StateTransition) refundGas(refundQuotient uint64) {
 	st.gp.AddGas(st.gasRemaining)
 }
 
+// FeeCredit is a part of transaction fee credited to a single recipient.
+type FeeCredit struct {
+	Recipient common.Address
+	Amount    *big.Int
+	Coinbase  bool // Whether the recipient is the block coinbase
+}
+
+// SplitFee distributes a transaction fee between its recipients. Before the fee
+// split fork, the whole fee is credited to the fee collector if it is configured
+// for the chain, or to the block coinbase otherwise. Since the fork, the fee is
+// split by the configured shares and the rounding remainder is credited to the
+// recipient of the last share.
+func SplitFee(config *params.ChainConfig, number *big.Int, coinbase common.Address, fee *big.Int) []FeeCredit {
+	if !config.IsFeeSplit(number) {
+		if config.FeeCollectorAddress != nil {
+			return []FeeCredit{{Recipient: *config.FeeCollectorAddress, Amount: fee}}
+		}
+		return []FeeCredit{{Recipient: coinbase, Amount: fee, Coinbase: true}}
+	}
+	var (
+		shares  = config.FeeSplit.Shares
+		credits = make([]FeeCredit, len(shares))
+		left    = new(big.Int).Set(fee)
+	)
+	for i, share := range shares {
+		credit := FeeCredit{Recipient: coinbase, Coinbase: true}
+		if share.Address != nil {
+			credit = FeeCredit{Recipient: *share.Address}
+		}
+		if i == len(shares)-1 {
+			credit.Amount = left
+		} else {
+			credit.Amount = new(big.Int).Mul(fee, new(big.Int).SetUint64(share.Percent))
+			credit.Amount.Div(credit.Amount, big.NewInt(100))
+			left.Sub(left, credit.Amount)
+		}
+		credits[i] = credit
+	}
+	return credits
+}
+
 // gasUsed returns the amount of gas used up by the state transition.
 func (st *StateTransition) gasUsed() uint64 {
 	return st.initialGas - st.gasRemaining
@@ -340,3 +340,116 @@ func TestSuccessfulMint(t *testing.T) {
 		BlockNumber: blockNum.Uint64(),
 	}, stateDb.Logs()[0])
 }
+
+func TestMintWithUsedBurnTx(t *testing.T) {
+	mintAmount := new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+	burnTxHash := common.HexToHash("0x621c759718a44e19ad04f8d133746b1043a2004f3fd68028cd28f1598388106e")
+	data := bytes.Join([][]byte{common.BigToHash(mintAmount).Bytes(), burnTxHash.Bytes(), {mint.BurnNetworkTron}}, []byte{})
+
+	chainConfig := *params.AllCliqueProtocolChanges
+	chainConfig.LondonBlock = nil
+	chainConfig.MintContract = &params.MintContractConfig{UniqueBurnTxBlock: big.NewInt(100)}
+
+	testCases := []struct {
+		blockNum       *big.Int
+		expectedErrors []string
+	}{
+		{blockNum: big.NewInt(99), expectedErrors: []string{"", ""}},
+		{blockNum: big.NewInt(100), expectedErrors: []string{"", "burn tx has already been minted"}},
+	}
+
+	for _, testCase := range testCases {
+		stateDb := prepareStateDb()
+		blockCtx := vm.BlockContext{
+			CanTransfer: func(db vm.StateDB, address common.Address, b *big.Int) bool { return true },
+			Transfer:    func(db vm.StateDB, address common.Address, address2 common.Address, b *big.Int) {},
+			BlockNumber: testCase.blockNum,
+		}
+		evm := vm.NewEVM(blockCtx, vm.TxContext{}, stateDb, &chainConfig, vm.Config{NoBaseFee: true})
+		signer := types.HomesteadSigner{}
+
+		for nonce, expectedError := range testCase.expectedErrors {
+			tx, _ := types.SignNewTx(ownerKey, signer, &types.LegacyTx{
+				Nonce:    uint64(nonce),
+				To:       &mint.Contract.Address,
+				Value:    new(big.Int),
+				Gas:      130000,
+				Data:     data,
+				GasPrice: big.NewInt(params.GWei),
+			})
+			message, _ := TransactionToMessage(tx, signer, nil)
+
+			result, err := ApplyMessage(evm, message, new(GasPool).AddGas(math.MaxUint64))
+			assert.NoError(t, err)
+			if expectedError == "" {
+				assert.NoError(t, result.Err)
+			} else {
+				assert.EqualError(t, result.Err, expectedError)
+			}
+		}
+
+		assert.Equal(t, testCase.blockNum.Cmp(big.NewInt(100)) >= 0, mint.IsBurnTxUsed(stateDb, mint.BurnNetworkTron, burnTxHash))
+		assert.False(t, mint.IsBurnTxUsed(stateDb, mint.BurnNetworkEthereum, burnTxHash))
+	}
+}
+
+func TestFeeSplit(t *testing.T) {
+	collector := common.HexToAddress("0x0000000000000000000000000000000000001001")
+	treasury := common.HexToAddress("0x0000000000000000000000000000000000001002")
+	coinbase := common.HexToAddress("0x00000000000000000000000000000000000c0ffe")
+
+	chainConfig := *params.AllCliqueProtocolChanges
+	chainConfig.LondonBlock = nil
+	chainConfig.FeeCollectorAddress = &collector
+	chainConfig.FeeSplit = &params.FeeSplitConfig{
+		Block: big.NewInt(100),
+		Shares: []*params.FeeShare{
+			{Address: &collector, Percent: 33},
+			{Address: &treasury, Percent: 33},
+			{Percent: 34},
+		},
+	}
+
+	// Rounding remainder is credited to the last share recipient
+	assert.Equal(t, []FeeCredit{
+		{Recipient: collector, Amount: big.NewInt(33)},
+		{Recipient: treasury, Amount: big.NewInt(33)},
+		{Recipient: coinbase, Amount: big.NewInt(35), Coinbase: true},
+	}, SplitFee(&chainConfig, big.NewInt(100), coinbase, big.NewInt(101)))
+
+	testCases := []struct {
+		blockNum *big.Int
+		balances map[common.Address]int64
+	}{
+		{blockNum: big.NewInt(99), balances: map[common.Address]int64{collector: 21000, treasury: 0, coinbase: 0}},
+		{blockNum: big.NewInt(100), balances: map[common.Address]int64{collector: 6930, treasury: 6930, coinbase: 7140}},
+	}
+
+	for _, testCase := range testCases {
+		stateDb := prepareStateDb()
+		blockCtx := vm.BlockContext{
+			CanTransfer: CanTransfer,
+			Transfer:    Transfer,
+			Coinbase:    coinbase,
+			BlockNumber: testCase.blockNum,
+		}
+		evm := vm.NewEVM(blockCtx, vm.TxContext{}, stateDb, &chainConfig, vm.Config{})
+		signer := types.HomesteadSigner{}
+
+		tx, _ := types.SignNewTx(ownerKey, signer, &types.LegacyTx{
+			To:       &common.Address{1},
+			Value:    new(big.Int),
+			Gas:      21000,
+			GasPrice: big.NewInt(1),
+		})
+		message, _ := TransactionToMessage(tx, signer, nil)
+
+		result, err := ApplyMessage(evm, message, new(GasPool).AddGas(math.MaxUint64))
+		assert.NoError(t, err)
+		assert.NoError(t, result.Err)
+
+		for addr, balance := range testCase.balances {
+			assert.Equal(t, big.NewInt(balance), stateDb.GetBalance(addr), "block %v, account %v", testCase.blockNum, addr)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	maxBundles       = 256 // Maximum number of bundles kept by the pool
+	maxSenderBundles = 16  // Maximum number of bundles including transactions of a single sender
+	maxBundleTxs     = 32  // Maximum number of transactions in a single bundle
+)
+
+var (
+	// ErrBundleEmpty is returned if a bundle contains no transactions.
+	ErrBundleEmpty = errors.New("bundle without transactions")
+
+	// ErrBundleTooLarge is returned if a bundle contains too many transactions.
+	ErrBundleTooLarge = errors.New("bundle contains too many transactions")
+
+	// ErrBundleExpired is returned if the target block of a bundle has already
+	// been reached.
+	ErrBundleExpired = errors.New("bundle target block reached")
+
+	// ErrBundleOverflow is returned if the bundle set is full.
+	ErrBundleOverflow = errors.New("too many bundles")
+
+	// ErrBundleSenderOverflow is returned if a sender of the bundle already has
+	// too many bundles pooled.
+	ErrBundleSenderOverflow = errors.New("too many bundles from sender")
+)
+
+// Bundle is an ordered list of transactions which must be included contiguously
+// in a single block, either all of them or none. Like private transactions,
+// bundles are never propagated and only included in blocks sealed locally.
+type Bundle struct {
+	Txs               types.Transactions
+	BlockNumber       uint64        // Block to include the bundle in, zero for any block until it expires
+	RevertingTxHashes []common.Hash // Transactions allowed to revert without invalidating the bundle
+
+	maxBlock uint64 // Highest block number the bundle can be included in
+}
+
+// Hash returns the hash of the ordered transaction hashes of the bundle.
+func (b *Bundle) Hash() common.Hash {
+	hashes := make([]byte, 0, len(b.Txs)*common.HashLength)
+	for _, tx := range b.Txs {
+		hashes = append(hashes, tx.Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(hashes)
+}
+
+// MayRevert returns whether the transaction with the given hash is allowed to
+// revert without invalidating the bundle.
+func (b *Bundle) MayRevert(hash common.Hash) bool {
+	for _, h := range b.RevertingTxHashes {
+		if h == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// AddBundle validates the transactions of the bundle and keeps it for the local
+// miner until its target block, or the configured private lifetime if there is
+// none. Since the transactions can depend on each other, only the first one of
+// each sender is validated against the nonce and balance in the current state.
+func (pool *TxPool) AddBundle(bundle *Bundle) error {
+	switch {
+	case len(bundle.Txs) == 0:
+		return ErrBundleEmpty
+	case len(bundle.Txs) > maxBundleTxs:
+		return ErrBundleTooLarge
+	}
+	for i, tx := range bundle.Txs {
+		if err := pool.validateTxBasics(tx, true); err != nil {
+			invalidTxMeter.Mark(1)
+			return fmt.Errorf("bundle tx %d: %w", i, err)
+		}
+		if err := pool.checkPolicy(tx); err != nil {
+			policyTxMeter.Mark(1)
+			return fmt.Errorf("bundle tx %d: %w", i, err)
+		}
+	}
+	hash := bundle.Hash()
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	for _, b := range pool.bundles {
+		if b.Hash() == hash {
+			return ErrAlreadyKnown
+		}
+	}
+	if err := pool.validateBundle(bundle); err != nil {
+		invalidTxMeter.Mark(1)
+		return err
+	}
+	head := pool.chain.CurrentBlock().Number.Uint64()
+	switch {
+	case bundle.BlockNumber == 0:
+		bundle.maxBlock = head + pool.config.PrivateLifetime
+	case bundle.BlockNumber <= head:
+		return ErrBundleExpired
+	default:
+		bundle.maxBlock = bundle.BlockNumber
+	}
+	if len(pool.bundles) >= maxBundles {
+		return ErrBundleOverflow
+	}
+	for from := range pool.bundleSenders(bundle) {
+		if pool.senderBundles(from) >= maxSenderBundles {
+			return ErrBundleSenderOverflow
+		}
+	}
+	pool.bundles = append(pool.bundles, bundle)
+	log.Debug("Pooled new bundle", "hash", hash, "txs", len(bundle.Txs), "target", bundle.BlockNumber, "maxBlock", bundle.maxBlock)
+	return nil
+}
+
+// validateBundle checks the transactions of the bundle against the current state.
+// The pool lock must be held.
+func (pool *TxPool) validateBundle(bundle *Bundle) error {
+	seen := make(map[common.Address]struct{})
+	for i, tx := range bundle.Txs {
+		from, _ := types.Sender(pool.signer, tx) // already validated
+		if _, ok := seen[from]; !ok {
+			seen[from] = struct{}{}
+			if pool.currentState.GetNonce(from) > tx.Nonce() {
+				return fmt.Errorf("bundle tx %d: %w", i, core.ErrNonceTooLow)
+			}
+			if pool.currentState.GetBalance(from).Cmp(tx.Cost()) < 0 {
+				return fmt.Errorf("bundle tx %d: %w", i, core.ErrInsufficientFunds)
+			}
+		}
+		// Mint instructions bound to fail would fail the whole bundle
+		if instruction, ok := pool.mintInstruction(tx); ok {
+			if err := pool.validateMint(from, tx, instruction); err != nil {
+				return fmt.Errorf("bundle tx %d: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// bundleSenders returns the senders of the transactions in the bundle.
+func (pool *TxPool) bundleSenders(bundle *Bundle) map[common.Address]struct{} {
+	senders := make(map[common.Address]struct{})
+	for _, tx := range bundle.Txs {
+		from, _ := types.Sender(pool.signer, tx) // already validated
+		senders[from] = struct{}{}
+	}
+	return senders
+}
+
+// senderBundles returns the number of pooled bundles including transactions of
+// the given sender. The pool lock must be held.
+func (pool *TxPool) senderBundles(from common.Address) int {
+	var count int
+	for _, bundle := range pool.bundles {
+		if _, ok := pool.bundleSenders(bundle)[from]; ok {
+			count++
+		}
+	}
+	return count
+}
+
+// RemoveBundle drops the bundle with the given hash, e.g. after it failed the
+// simulation on top of the pending state.
+func (pool *TxPool) RemoveBundle(hash common.Hash) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	for i, bundle := range pool.bundles {
+		if bundle.Hash() == hash {
+			copy(pool.bundles[i:], pool.bundles[i+1:])
+			pool.bundles[len(pool.bundles)-1] = nil
+			pool.bundles = pool.bundles[:len(pool.bundles)-1]
+			log.Debug("Dropping bundle", "hash", hash)
+			return
+		}
+	}
+}
+
+// Bundles retrieves the bundles which can be included in the block with the
+// given number, in the order of arrival.
+func (pool *TxPool) Bundles(number uint64) []*Bundle {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	var bundles []*Bundle
+	for _, bundle := range pool.bundles {
+		if (bundle.BlockNumber == 0 || bundle.BlockNumber == number) && bundle.maxBlock >= number {
+			bundles = append(bundles, bundle)
+		}
+	}
+	return bundles
+}
+
+// expireBundles drops the bundles which can no longer be included after the
+// given head, either due to their target block or stale nonces. The pool lock
+// must be held.
+func (pool *TxPool) expireBundles(head uint64) {
+	bundles := pool.bundles[:0]
+	for _, bundle := range pool.bundles {
+		if bundle.maxBlock > head && !pool.staleBundle(bundle) {
+			bundles = append(bundles, bundle)
+			continue
+		}
+		log.Debug("Dropping bundle", "hash", bundle.Hash(), "maxBlock", bundle.maxBlock)
+	}
+	for i := len(bundles); i < len(pool.bundles); i++ {
+		pool.bundles[i] = nil
+	}
+	pool.bundles = bundles
+}
+
+// staleBundle returns whether any transaction of the bundle has a nonce already
+// used in the current state. The pool lock must be held.
+func (pool *TxPool) staleBundle(bundle *Bundle) bool {
+	for _, tx := range bundle.Txs {
+		from, _ := types.Sender(pool.signer, tx) // already validated
+		if tx.Nonce() < pool.currentState.GetNonce(from) {
+			return true
+		}
+	}
+	return false
+}
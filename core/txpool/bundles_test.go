@@ -0,0 +1,106 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundles(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+
+	// Bundles are validated in isolation and kept out of the pool lookups
+	assert.ErrorIs(t, pool.AddBundle(&Bundle{}), ErrBundleEmpty)
+	assert.ErrorIs(t, pool.AddBundle(&Bundle{Txs: make(types.Transactions, maxBundleTxs+1)}), ErrBundleTooLarge)
+
+	stale := &Bundle{Txs: types.Transactions{pricedTransaction(0, 100000, big.NewInt(1), key)}}
+	open := &Bundle{Txs: types.Transactions{
+		pricedTransaction(1, 100000, big.NewInt(1), key),
+		pricedTransaction(2, 100000, big.NewInt(1), key),
+	}}
+	target := &Bundle{Txs: types.Transactions{pricedTransaction(3, 100000, big.NewInt(1), key)}, BlockNumber: 2}
+	last := &Bundle{Txs: types.Transactions{pricedTransaction(4, 100000, big.NewInt(1), key)}}
+	for _, bundle := range []*Bundle{stale, open, target, last} {
+		require.NoError(t, pool.AddBundle(bundle))
+	}
+	assert.ErrorIs(t, pool.AddBundle(&Bundle{Txs: open.Txs, BlockNumber: 1}), ErrAlreadyKnown)
+
+	pending, queued := pool.Stats()
+	assert.Zero(t, pending+queued)
+	assert.Nil(t, pool.Get(open.Txs[0].Hash()))
+
+	// Bundles are handed out in arrival order, targeted ones only for their block
+	assert.Equal(t, []*Bundle{stale, open, last}, pool.Bundles(1))
+	assert.Equal(t, []*Bundle{stale, open, target, last}, pool.Bundles(2))
+
+	// Bundles are dropped once a nonce is used or their target block is reached
+	pool.chain.(*testBlockChain).statedb.SetNonce(from, 1)
+	<-pool.requestReset(nil, &types.Header{Number: big.NewInt(2), GasLimit: 10000000, BaseFee: big.NewInt(1)})
+	assert.Equal(t, []*Bundle{open, last}, pool.Bundles(3))
+
+	<-pool.requestReset(nil, &types.Header{Number: big.NewInt(25), GasLimit: 10000000, BaseFee: big.NewInt(1)})
+	assert.Empty(t, pool.Bundles(26))
+}
+
+func TestBundleValidation(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+	pool.chain.(*testBlockChain).statedb.SetNonce(from, 1)
+	<-pool.requestReset(nil, &types.Header{Number: big.NewInt(1), GasLimit: 10000000, BaseFee: big.NewInt(1)})
+
+	// The first transaction of each sender is checked against the state
+	assert.ErrorIs(t, pool.AddBundle(&Bundle{Txs: types.Transactions{pricedTransaction(0, 100000, big.NewInt(1), key)}}), core.ErrNonceTooLow)
+	assert.ErrorIs(t, pool.AddBundle(&Bundle{Txs: types.Transactions{pricedTransaction(1, 100000, big.NewInt(100000), key)}}), core.ErrInsufficientFunds)
+
+	// Transactions of the bundle are subject to the admission policy
+	require.NoError(t, pool.SetPolicy(&Policy{Deny: []common.Address{from}}))
+	assert.ErrorIs(t, pool.AddBundle(&Bundle{Txs: types.Transactions{pricedTransaction(1, 100000, big.NewInt(1), key)}}), ErrPolicyDenied)
+	require.NoError(t, pool.SetPolicy(nil))
+
+	// The number of bundles from a single sender is limited
+	var bundles []*Bundle
+	for i := 0; i < maxSenderBundles; i++ {
+		bundle := &Bundle{Txs: types.Transactions{pricedTransaction(1, 100000, big.NewInt(int64(i+1)), key)}}
+		require.NoError(t, pool.AddBundle(bundle))
+		bundles = append(bundles, bundle)
+	}
+	assert.ErrorIs(t, pool.AddBundle(&Bundle{Txs: types.Transactions{pricedTransaction(1, 100000, big.NewInt(maxSenderBundles+1), key)}}), ErrBundleSenderOverflow)
+
+	// Removing a bundle makes room for a new one
+	pool.RemoveBundle(bundles[0].Hash())
+	assert.Equal(t, bundles[1:], pool.Bundles(2))
+	assert.NoError(t, pool.AddBundle(&Bundle{Txs: types.Transactions{pricedTransaction(1, 100000, big.NewInt(maxSenderBundles+1), key)}}))
+}
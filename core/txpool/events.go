@@ -0,0 +1,118 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// TxEventReason is the cause of a transaction leaving the pool or being moved
+// back from the pending to the queued set.
+type TxEventReason string
+
+const (
+	TxEventReplaced    TxEventReason = "replaced"    // Replaced by a transaction with the same nonce and a higher price
+	TxEventUnderpriced TxEventReason = "underpriced" // Dropped from a full pool or below the raised price limit
+	TxEventEvicted     TxEventReason = "evicted"     // Queued for longer than the pool lifetime
+	TxEventDemoted     TxEventReason = "demoted"     // Moved back to the queue after a reorg or a removal before it
+	TxEventIncluded    TxEventReason = "included"    // Included in a block of the canonical chain
+	TxEventStale       TxEventReason = "stale"       // Nonce was used by another transaction included in the chain
+	TxEventUnpayable   TxEventReason = "unpayable"   // Sender balance or block gas limit is too low
+	TxEventCapped      TxEventReason = "capped"      // Dropped to keep the account or global slot limits
+	TxEventExpired     TxEventReason = "expired"     // Private transaction reached its maximum block number
+)
+
+// TxEvent is a state transition of a transaction in the pool.
+type TxEvent struct {
+	Hash        common.Hash        `json:"hash"`
+	From        common.Address     `json:"from"`
+	Nonce       uint64             `json:"nonce"`
+	Reason      TxEventReason      `json:"reason"`
+	Replacement *common.Hash       `json:"replacement,omitempty"` // Hash of the replacing transaction
+	Tx          *types.Transaction `json:"-"`
+}
+
+// TxEventsEvent is posted when transactions leave the pool or get demoted.
+type TxEventsEvent struct{ Events []*TxEvent }
+
+// SubscribeTxEvents registers a subscription of TxEventsEvent and starts
+// sending event to the given channel.
+func (pool *TxPool) SubscribeTxEvents(ch chan<- TxEventsEvent) event.Subscription {
+	return pool.scope.Track(pool.txEventFeed.Subscribe(ch))
+}
+
+// recordTxEvent records a transaction state transition to be posted once the
+// pool lock is released. The pool lock must be held.
+func (pool *TxPool) recordTxEvent(tx *types.Transaction, reason TxEventReason, replacement *types.Transaction) {
+	from, _ := types.Sender(pool.signer, tx) // already validated
+	ev := &TxEvent{
+		Hash:   tx.Hash(),
+		From:   from,
+		Nonce:  tx.Nonce(),
+		Reason: reason,
+		Tx:     tx,
+	}
+	if replacement != nil {
+		hash := replacement.Hash()
+		ev.Replacement = &hash
+	}
+	pool.txEvents = append(pool.txEvents, ev)
+}
+
+// recordTxEvents records the same state transition of several transactions.
+// The pool lock must be held.
+func (pool *TxPool) recordTxEvents(txs []*types.Transaction, reason TxEventReason) {
+	for _, tx := range txs {
+		pool.recordTxEvent(tx, reason, nil)
+	}
+}
+
+// recordForwardedTxEvents records the transactions dropped because their nonce
+// was used by the chain, telling apart the ones included by the last reset from
+// the stale ones. The pool lock must be held.
+func (pool *TxPool) recordForwardedTxEvents(txs []*types.Transaction) {
+	for _, tx := range txs {
+		pool.recordTxEvent(tx, pool.forwardedReason(tx), nil)
+	}
+}
+
+// forwardedReason returns the reason of a transaction dropped because its nonce
+// was used by the chain. The pool lock must be held.
+func (pool *TxPool) forwardedReason(tx *types.Transaction) TxEventReason {
+	if _, ok := pool.included[tx.Hash()]; ok {
+		return TxEventIncluded
+	}
+	return TxEventStale
+}
+
+// takeTxEvents returns the transaction events recorded since the last call.
+// The pool lock must be held.
+func (pool *TxPool) takeTxEvents() []*TxEvent {
+	events := pool.txEvents
+	pool.txEvents = nil
+	return events
+}
+
+// sendTxEvents posts the given transaction events to the subscribers. It must
+// be called without holding the pool lock.
+func (pool *TxPool) sendTxEvents(events []*TxEvent) {
+	if len(events) > 0 {
+		pool.txEventFeed.Send(TxEventsEvent{Events: events})
+	}
+}
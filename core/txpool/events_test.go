@@ -0,0 +1,130 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockTestChain is a test blockchain returning the given block on lookups.
+type blockTestChain struct {
+	*testBlockChain
+	block *types.Block
+}
+
+func (bc *blockTestChain) GetBlock(hash common.Hash, number uint64) *types.Block {
+	return bc.block
+}
+
+// collectTxEvents reads the given number of transaction events from the channel.
+func collectTxEvents(t *testing.T, ch chan TxEventsEvent, count int) []*TxEvent {
+	var events []*TxEvent
+	for len(events) < count {
+		select {
+		case ev := <-ch:
+			events = append(events, ev.Events...)
+		case <-time.After(time.Second):
+			t.Fatalf("event timeout: have %d, want %d", len(events), count)
+		}
+	}
+	select {
+	case ev := <-ch:
+		t.Fatalf("unexpected events: %v", ev.Events)
+	case <-time.After(50 * time.Millisecond):
+	}
+	return events
+}
+
+func TestTxEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		key, _      = crypto.GenerateKey()
+		other, _    = crypto.GenerateKey()
+		tx0         = pricedTransaction(0, 100000, big.NewInt(1), key)
+		replacement = pricedTransaction(0, 100000, big.NewInt(2), key)
+		stale       = pricedTransaction(0, 100000, big.NewInt(1), other)
+	)
+	// The next block includes the replacement transaction only
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := &blockTestChain{testBlockChain: newTestBlockChain(10000000, statedb, new(event.Feed))}
+	head := &types.Header{ParentHash: blockchain.CurrentBlock().Hash(), Number: big.NewInt(1), GasLimit: 10000000, BaseFee: big.NewInt(1)}
+	blockchain.block = types.NewBlock(head, types.Transactions{replacement}, nil, nil, trie.NewStackTrie(nil))
+
+	pool := NewTxPool(testTxPoolConfig, params.TestChainConfig, blockchain)
+	<-pool.initDoneCh
+	defer pool.Stop()
+
+	ch := make(chan TxEventsEvent, 16)
+	sub := pool.SubscribeTxEvents(ch)
+	defer sub.Unsubscribe()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+	testAddBalance(pool, crypto.PubkeyToAddress(other.PublicKey), big.NewInt(1000000000))
+
+	// Replaced transactions refer to their replacement
+	require.NoError(t, pool.addRemoteSync(tx0))
+	require.NoError(t, pool.addRemoteSync(replacement))
+
+	events := collectTxEvents(t, ch, 1)
+	hash := replacement.Hash()
+	assert.Equal(t, &TxEvent{Hash: tx0.Hash(), From: from, Nonce: 0, Reason: TxEventReplaced, Replacement: &hash, Tx: tx0}, events[0])
+
+	// Included, stale, unpayable and demoted transactions are reported on reset
+	tx1 := pricedTransaction(1, 100000, big.NewInt(100), key)
+	tx2 := pricedTransaction(2, 100000, big.NewInt(1), key)
+	require.NoError(t, pool.addRemoteSync(tx1))
+	require.NoError(t, pool.addRemoteSync(tx2))
+	require.NoError(t, pool.addRemoteSync(stale))
+
+	statedb.SetNonce(from, 1)
+	statedb.SetNonce(crypto.PubkeyToAddress(other.PublicKey), 1)
+	statedb.SetBalance(from, big.NewInt(1000000))
+	<-pool.requestReset(blockchain.CurrentBlock(), head)
+
+	events = collectTxEvents(t, ch, 4)
+	reasons := make(map[common.Hash]TxEventReason)
+	for _, ev := range events {
+		reasons[ev.Hash] = ev.Reason
+	}
+	assert.Equal(t, map[common.Hash]TxEventReason{
+		replacement.Hash(): TxEventIncluded,
+		stale.Hash():       TxEventStale,
+		tx1.Hash():         TxEventUnpayable,
+		tx2.Hash():         TxEventDemoted,
+	}, reasons)
+
+	// Raising the price limit drops the underpriced remote transactions
+	pool.SetGasPrice(big.NewInt(2))
+	events = collectTxEvents(t, ch, 1)
+	assert.Equal(t, tx2.Hash(), events[0].Hash)
+	assert.Equal(t, TxEventUnderpriced, events[0].Reason)
+}
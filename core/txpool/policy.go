@@ -0,0 +1,369 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// maxPolicyWindows is the number of rate limit windows tracked before the
+// expired ones are swept.
+const maxPolicyWindows = 16384
+
+var (
+	// ErrPolicyDenied is returned if the sender or the recipient of a transaction
+	// is on the deny list of the pool policy.
+	ErrPolicyDenied = errors.New("transaction denied by pool policy")
+
+	// ErrPolicyRateLimited is returned if a transaction exceeds a rate limit of the
+	// pool policy.
+	ErrPolicyRateLimited = errors.New("transaction rate limit exceeded")
+
+	// policyTxMeter counts the transactions rejected by the pool policy.
+	policyTxMeter = metrics.NewRegisteredMeter("txpool/policy", nil)
+)
+
+// RateLimit is a maximum number of transactions admitted within a period.
+type RateLimit struct {
+	Count  uint64 `json:"count"`  // Number of transactions admitted per period
+	Period uint64 `json:"period"` // Length of the period in seconds
+}
+
+// SenderClass is a group of senders sharing the same admission rules.
+type SenderClass struct {
+	Name        string                `json:"name"`
+	Senders     []common.Address      `json:"senders,omitempty"`
+	MinGasPrice *math.HexOrDecimal256 `json:"minGasPrice,omitempty"` // Minimum gas tip accepted from the senders
+	Rate        *RateLimit            `json:"rate,omitempty"`        // Rate limit applied to each sender separately
+}
+
+// ContractQuota limits the transactions sent to a single destination contract.
+type ContractQuota struct {
+	Address    common.Address `json:"address"`
+	Rate       *RateLimit     `json:"rate,omitempty"`       // Rate limit of all senders together
+	SenderRate *RateLimit     `json:"senderRate,omitempty"` // Rate limit applied to each sender separately
+}
+
+// Policy is a set of per-address admission rules applied on top of the global
+// pool limits. Unlike the price limit, the policy applies to local transactions
+// too, since transactions submitted over RPC are treated as local.
+//
+// Senders on the allow list bypass the policy entirely, while transactions from
+// or to addresses on the deny list are never admitted. Senders not listed in
+// any class fall into the default class.
+type Policy struct {
+	Allow     []common.Address `json:"allow,omitempty"`
+	Deny      []common.Address `json:"deny,omitempty"`
+	Default   *SenderClass     `json:"default,omitempty"`
+	Classes   []*SenderClass   `json:"classes,omitempty"`
+	Contracts []*ContractQuota `json:"contracts,omitempty"`
+}
+
+// LoadPolicy reads and validates the policy from a JSON file.
+func LoadPolicy(file string) (*Policy, error) {
+	blob, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	policy := new(Policy)
+	if err := json.Unmarshal(blob, policy); err != nil {
+		return nil, fmt.Errorf("invalid txpool policy %s: %v", file, err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid txpool policy %s: %v", file, err)
+	}
+	return policy, nil
+}
+
+// validate checks the rate limit for zero values.
+func (r *RateLimit) validate() error {
+	if r != nil && (r.Count == 0 || r.Period == 0) {
+		return errors.New("rate limit count and period must be positive")
+	}
+	return nil
+}
+
+// Validate checks the policy for conflicting or unworkable rules.
+func (p *Policy) Validate() error {
+	deny := make(map[common.Address]struct{}, len(p.Deny))
+	for _, addr := range p.Deny {
+		deny[addr] = struct{}{}
+	}
+	for _, addr := range p.Allow {
+		if _, ok := deny[addr]; ok {
+			return fmt.Errorf("address %s is both allowed and denied", addr)
+		}
+	}
+	if p.Default != nil {
+		if len(p.Default.Senders) > 0 {
+			return errors.New("default class cannot list senders")
+		}
+		if err := p.Default.Rate.validate(); err != nil {
+			return fmt.Errorf("default class: %v", err)
+		}
+	}
+	var (
+		names   = make(map[string]struct{})
+		senders = make(map[common.Address]string)
+	)
+	for _, class := range p.Classes {
+		if class == nil || class.Name == "" {
+			return errors.New("sender class without name")
+		}
+		if _, ok := names[class.Name]; ok {
+			return fmt.Errorf("duplicate sender class %q", class.Name)
+		}
+		names[class.Name] = struct{}{}
+
+		for _, sender := range class.Senders {
+			if name, ok := senders[sender]; ok {
+				return fmt.Errorf("sender %s is in both %q and %q classes", sender, name, class.Name)
+			}
+			senders[sender] = class.Name
+		}
+		if err := class.Rate.validate(); err != nil {
+			return fmt.Errorf("sender class %q: %v", class.Name, err)
+		}
+	}
+	contracts := make(map[common.Address]struct{})
+	for _, quota := range p.Contracts {
+		if quota == nil {
+			return errors.New("empty contract quota")
+		}
+		if _, ok := contracts[quota.Address]; ok {
+			return fmt.Errorf("duplicate contract quota %s", quota.Address)
+		}
+		contracts[quota.Address] = struct{}{}
+
+		if err := quota.Rate.validate(); err != nil {
+			return fmt.Errorf("contract quota %s: %v", quota.Address, err)
+		}
+		if err := quota.SenderRate.validate(); err != nil {
+			return fmt.Errorf("contract quota %s: %v", quota.Address, err)
+		}
+	}
+	return nil
+}
+
+// rateKey identifies a rate limit window. Either of the addresses is left empty
+// for windows counting all the transactions of a sender or to a contract.
+type rateKey struct {
+	sender   common.Address
+	contract common.Address
+	quota    bool // Distinguishes the per-sender contract quotas from the sender class limits
+}
+
+// rateWindow counts the transactions admitted within the current period.
+type rateWindow struct {
+	start time.Time
+	count uint64
+}
+
+// policyState is a policy indexed for the lookups along with the rate limit
+// windows of the senders and contracts.
+type policyState struct {
+	policy    *Policy
+	allow     map[common.Address]struct{}
+	deny      map[common.Address]struct{}
+	classes   map[common.Address]*SenderClass
+	contracts map[common.Address]*ContractQuota
+
+	windows map[rateKey]*rateWindow
+	lock    sync.Mutex
+}
+
+// newPolicyState indexes the given, already validated policy.
+func newPolicyState(policy *Policy) *policyState {
+	s := &policyState{
+		policy:    policy,
+		allow:     make(map[common.Address]struct{}, len(policy.Allow)),
+		deny:      make(map[common.Address]struct{}, len(policy.Deny)),
+		classes:   make(map[common.Address]*SenderClass),
+		contracts: make(map[common.Address]*ContractQuota, len(policy.Contracts)),
+		windows:   make(map[rateKey]*rateWindow),
+	}
+	for _, addr := range policy.Allow {
+		s.allow[addr] = struct{}{}
+	}
+	for _, addr := range policy.Deny {
+		s.deny[addr] = struct{}{}
+	}
+	for _, class := range policy.Classes {
+		for _, sender := range class.Senders {
+			s.classes[sender] = class
+		}
+	}
+	for _, quota := range policy.Contracts {
+		s.contracts[quota.Address] = quota
+	}
+	return s
+}
+
+// check ensures the transaction adheres to the policy and charges it against
+// the rate limits it is subject to. The rate limits are charged even if the
+// transaction is rejected by the pool afterwards, so invalid transactions can
+// not be used to circumvent them.
+func (s *policyState) check(from common.Address, tx *types.Transaction, now time.Time) error {
+	if _, ok := s.allow[from]; ok {
+		return nil
+	}
+	if _, ok := s.deny[from]; ok {
+		return ErrPolicyDenied
+	}
+	if to := tx.To(); to != nil {
+		if _, ok := s.deny[*to]; ok {
+			return ErrPolicyDenied
+		}
+	}
+	class := s.classes[from]
+	if class == nil {
+		class = s.policy.Default
+	}
+	if class != nil && class.MinGasPrice != nil {
+		if minPrice := (*big.Int)(class.MinGasPrice); tx.GasTipCapIntCmp(minPrice) < 0 {
+			return fmt.Errorf("%w: sender class %q requires tip %v", ErrUnderpriced, class.Name, minPrice)
+		}
+	}
+	// Collect all the windows to charge, bailing out if any of them is exhausted
+	type charge struct {
+		key  rateKey
+		rate *RateLimit
+	}
+	var charges []charge
+	if class != nil && class.Rate != nil {
+		charges = append(charges, charge{rateKey{sender: from}, class.Rate})
+	}
+	if to := tx.To(); to != nil {
+		if quota := s.contracts[*to]; quota != nil {
+			if quota.Rate != nil {
+				charges = append(charges, charge{rateKey{contract: *to, quota: true}, quota.Rate})
+			}
+			if quota.SenderRate != nil {
+				charges = append(charges, charge{rateKey{sender: from, contract: *to, quota: true}, quota.SenderRate})
+			}
+		}
+	}
+	if len(charges) == 0 {
+		return nil
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	windows := make([]*rateWindow, len(charges))
+	for i, c := range charges {
+		window := s.windows[c.key]
+		if window == nil || now.Sub(window.start) >= time.Duration(c.rate.Period)*time.Second {
+			window = &rateWindow{start: now}
+		}
+		if window.count >= c.rate.Count {
+			return ErrPolicyRateLimited
+		}
+		windows[i] = window
+	}
+	if len(s.windows)+len(charges) > maxPolicyWindows {
+		s.sweep(now)
+	}
+	for i, c := range charges {
+		windows[i].count++
+		s.windows[c.key] = windows[i]
+	}
+	return nil
+}
+
+// sweep drops the rate limit windows which can no longer limit anything. The
+// lock must be held.
+func (s *policyState) sweep(now time.Time) {
+	for key, window := range s.windows {
+		if now.Sub(window.start) >= time.Duration(s.period(key))*time.Second {
+			delete(s.windows, key)
+		}
+	}
+}
+
+// period returns the period of the rate limit tracked by the given window key.
+func (s *policyState) period(key rateKey) uint64 {
+	if !key.quota {
+		if class := s.classes[key.sender]; class != nil {
+			return class.Rate.Period
+		}
+		return s.policy.Default.Rate.Period
+	}
+	quota := s.contracts[key.contract]
+	if key.sender == (common.Address{}) {
+		return quota.Rate.Period
+	}
+	return quota.SenderRate.Period
+}
+
+// Policy returns the admission policy currently enforced by the pool, or nil if
+// there is none.
+func (pool *TxPool) Policy() *Policy {
+	if state := pool.policy.Load(); state != nil {
+		return state.policy
+	}
+	return nil
+}
+
+// SetPolicy validates and replaces the admission policy enforced by the pool,
+// resetting all the rate limits. A nil policy removes the current one. The
+// transactions already in the pool are not affected.
+func (pool *TxPool) SetPolicy(policy *Policy) error {
+	if policy == nil {
+		pool.policy.Store(nil)
+		return nil
+	}
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	pool.policy.Store(newPolicyState(policy))
+	return nil
+}
+
+// ReloadPolicy replaces the admission policy enforced by the pool with the one
+// in the configured policy file.
+func (pool *TxPool) ReloadPolicy() error {
+	if pool.config.PolicyFile == "" {
+		return errors.New("txpool policy file is not configured")
+	}
+	policy, err := LoadPolicy(pool.config.PolicyFile)
+	if err != nil {
+		return err
+	}
+	return pool.SetPolicy(policy)
+}
+
+// checkPolicy ensures the transaction adheres to the admission policy of the pool.
+func (pool *TxPool) checkPolicy(tx *types.Transaction) error {
+	state := pool.policy.Load()
+	if state == nil {
+		return nil
+	}
+	// Sender has been recovered already, this cannot error.
+	from, _ := types.Sender(pool.signer, tx)
+	return state.check(from, tx, time.Now())
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func contractTransaction(nonce uint64, to common.Address, gasprice *big.Int, key *ecdsa.PrivateKey) *types.Transaction {
+	tx, _ := types.SignTx(types.NewTransaction(nonce, to, big.NewInt(0), 100000, gasprice, nil), types.HomesteadSigner{}, key)
+	return tx
+}
+
+func TestPolicy(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Stop()
+
+	var (
+		keys     = []*ecdsa.PrivateKey{key, nil, nil, nil}
+		addrs    = make([]common.Address, len(keys))
+		nonces   = make([]uint64, len(keys))
+		contract = common.HexToAddress("0x0000000000000000000000000000000000001001")
+		denied   = common.HexToAddress("0x0000000000000000000000000000000000001002")
+	)
+	for i := range keys {
+		if keys[i] == nil {
+			keys[i], _ = crypto.GenerateKey()
+		}
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+		testAddBalance(pool, addrs[i], big.NewInt(1000000000000))
+	}
+	send := func(i int, to common.Address, price int64) error {
+		err := pool.AddLocal(contractTransaction(nonces[i], to, big.NewInt(price), keys[i]))
+		if err == nil {
+			nonces[i]++
+		}
+		return err
+	}
+	minPrice, partnerPrice := (*math.HexOrDecimal256)(big.NewInt(10)), (*math.HexOrDecimal256)(big.NewInt(2))
+	require.NoError(t, pool.SetPolicy(&Policy{
+		Allow:   []common.Address{addrs[0]},
+		Deny:    []common.Address{addrs[3], denied},
+		Default: &SenderClass{Name: "default", MinGasPrice: minPrice},
+		Classes: []*SenderClass{
+			{Name: "partners", Senders: []common.Address{addrs[2]}, MinGasPrice: partnerPrice, Rate: &RateLimit{Count: 2, Period: 3600}},
+		},
+		Contracts: []*ContractQuota{
+			{Address: contract, Rate: &RateLimit{Count: 3, Period: 3600}, SenderRate: &RateLimit{Count: 2, Period: 3600}},
+		},
+	}))
+
+	// Denied senders and recipients are rejected
+	assert.ErrorIs(t, send(3, common.Address{}, 10), ErrPolicyDenied)
+	assert.ErrorIs(t, send(1, denied, 10), ErrPolicyDenied)
+
+	// Minimum gas price depends on the sender class, allowed senders bypass it
+	assert.ErrorIs(t, send(1, common.Address{}, 5), ErrUnderpriced)
+	assert.NoError(t, send(1, common.Address{}, 10))
+	assert.NoError(t, send(2, common.Address{}, 5))
+	assert.NoError(t, send(0, common.Address{}, 1))
+
+	// Contract quotas apply per sender and for all senders together
+	assert.NoError(t, send(1, contract, 10))
+	assert.NoError(t, send(1, contract, 10))
+	assert.ErrorIs(t, send(1, contract, 10), ErrPolicyRateLimited)
+	assert.NoError(t, send(2, contract, 5))
+	assert.ErrorIs(t, send(2, contract, 5), ErrPolicyRateLimited) // Sender class limit
+	assert.NoError(t, send(0, contract, 1))
+
+	// Removing the policy lifts all the limits
+	require.NoError(t, pool.SetPolicy(nil))
+	assert.Nil(t, pool.Policy())
+	assert.NoError(t, send(3, contract, 1))
+}
+
+func TestLoadPolicy(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "policy.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{
+		"deny": ["0x0000000000000000000000000000000000000001"],
+		"default": {"name": "default", "minGasPrice": "1000000000", "rate": {"count": 10, "period": 60}},
+		"contracts": [{"address": "0x0000000000000000000000000000000000001001", "senderRate": {"count": 1, "period": 600}}]
+	}`), 0644))
+
+	policy, err := LoadPolicy(file)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{{19: 1}}, policy.Deny)
+	assert.Equal(t, big.NewInt(1000000000), (*big.Int)(policy.Default.MinGasPrice))
+	assert.Equal(t, &RateLimit{Count: 1, Period: 600}, policy.Contracts[0].SenderRate)
+
+	for i, invalid := range []*Policy{
+		{Allow: []common.Address{{1}}, Deny: []common.Address{{1}}},
+		{Default: &SenderClass{Senders: []common.Address{{1}}}},
+		{Classes: []*SenderClass{{Name: "a"}, {Name: "a"}}},
+		{Classes: []*SenderClass{{Name: "a", Senders: []common.Address{{1}}}, {Name: "b", Senders: []common.Address{{1}}}}},
+		{Contracts: []*ContractQuota{{Rate: &RateLimit{Count: 1}}}},
+	} {
+		assert.Error(t, invalid.Validate(), "policy %d", i)
+	}
+}
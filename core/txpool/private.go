@@ -0,0 +1,145 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// maxPrivateTxs is the maximum number of private transactions kept by the pool.
+const maxPrivateTxs = 1024
+
+var (
+	// ErrPrivateTxExpired is returned if the maximum block number of a private
+	// transaction has already been reached.
+	ErrPrivateTxExpired = errors.New("private transaction max block number reached")
+
+	// ErrPrivateTxOverflow is returned if the private transaction set is full.
+	ErrPrivateTxOverflow = errors.New("too many private transactions")
+)
+
+// privateTx is a transaction kept off the network, to be included only in the
+// blocks sealed by the local node.
+type privateTx struct {
+	tx       *types.Transaction
+	from     common.Address
+	maxBlock uint64 // Highest block number the transaction can be included in
+}
+
+// AddPrivate validates a transaction and keeps it out of the pool lookups, thus
+// it is never announced or propagated to the peers. Private transactions are
+// only handed to the local miner and dropped once the chain reaches the given
+// maximum block number, or the configured lifetime if it's zero.
+func (pool *TxPool) AddPrivate(tx *types.Transaction, maxBlock uint64) error {
+	if pool.all.Get(tx.Hash()) != nil {
+		return ErrAlreadyKnown
+	}
+	if err := pool.validateTxBasics(tx, true); err != nil {
+		invalidTxMeter.Mark(1)
+		return err
+	}
+	if err := pool.checkPolicy(tx); err != nil {
+		policyTxMeter.Mark(1)
+		return err
+	}
+	pool.mu.Lock()
+	err := pool.addPrivateLocked(tx, maxBlock)
+	events := pool.takeTxEvents()
+	pool.mu.Unlock()
+
+	pool.sendTxEvents(events)
+	return err
+}
+
+// addPrivateLocked validates and stores a private transaction. The pool lock
+// must be held.
+func (pool *TxPool) addPrivateLocked(tx *types.Transaction, maxBlock uint64) error {
+	if _, ok := pool.private[tx.Hash()]; ok {
+		return ErrAlreadyKnown
+	}
+	head := pool.chain.CurrentBlock().Number.Uint64()
+	if maxBlock == 0 {
+		maxBlock = head + pool.config.PrivateLifetime
+	}
+	if maxBlock <= head {
+		return ErrPrivateTxExpired
+	}
+	if err := pool.validateTx(tx, true); err != nil {
+		return err
+	}
+	from, _ := types.Sender(pool.signer, tx) // already validated
+
+	// Replace a private transaction with the same nonce if the price is bumped
+	for hash, ptx := range pool.private {
+		if ptx.from != from || ptx.tx.Nonce() != tx.Nonce() {
+			continue
+		}
+		bump := new(big.Int).Mul(ptx.tx.GasTipCap(), big.NewInt(100+int64(pool.config.PriceBump)))
+		if new(big.Int).Mul(tx.GasTipCap(), big.NewInt(100)).Cmp(bump) < 0 {
+			return ErrReplaceUnderpriced
+		}
+		delete(pool.private, hash)
+		pool.recordTxEvent(ptx.tx, TxEventReplaced, tx)
+	}
+	if len(pool.private) >= maxPrivateTxs {
+		return ErrPrivateTxOverflow
+	}
+	pool.private[tx.Hash()] = &privateTx{tx: tx, from: from, maxBlock: maxBlock}
+	log.Debug("Pooled new private transaction", "hash", tx.Hash(), "from", from, "to", tx.To(), "maxBlock", maxBlock)
+	return nil
+}
+
+// Private retrieves the private transactions which can be included in the block
+// with the given number, grouped by origin account and sorted by nonce.
+func (pool *TxPool) Private(number uint64) map[common.Address]types.Transactions {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	private := make(map[common.Address]types.Transactions)
+	for _, ptx := range pool.private {
+		if ptx.maxBlock >= number {
+			private[ptx.from] = append(private[ptx.from], ptx.tx)
+		}
+	}
+	for _, txs := range private {
+		sort.Sort(types.TxByNonce(txs))
+	}
+	return private
+}
+
+// expirePrivate drops the private transactions which became stale or can no
+// longer be included after the given head. The pool lock must be held.
+func (pool *TxPool) expirePrivate(head uint64) {
+	for hash, ptx := range pool.private {
+		switch {
+		case ptx.tx.Nonce() < pool.currentState.GetNonce(ptx.from):
+			pool.recordTxEvent(ptx.tx, pool.forwardedReason(ptx.tx), nil)
+		case ptx.maxBlock <= head:
+			log.Debug("Dropping expired private transaction", "hash", hash, "maxBlock", ptx.maxBlock)
+			pool.recordTxEvent(ptx.tx, TxEventExpired, nil)
+		default:
+			continue
+		}
+		delete(pool.private, hash)
+	}
+}
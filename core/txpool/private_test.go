@@ -0,0 +1,109 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivateTransactions(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Stop()
+
+	txs := make(chan core.NewTxsEvent, 16)
+	sub := pool.SubscribeNewTxsEvent(txs)
+	defer sub.Unsubscribe()
+
+	events := make(chan TxEventsEvent, 16)
+	eventsSub := pool.SubscribeTxEvents(events)
+	defer eventsSub.Unsubscribe()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+
+	// Private transactions are kept out of the pool lookups and never announced
+	tx0 := pricedTransaction(0, 100000, big.NewInt(1), key)
+	tx1 := pricedTransaction(1, 100000, big.NewInt(1), key)
+	tx2 := pricedTransaction(2, 100000, big.NewInt(1), key)
+	require.NoError(t, pool.AddPrivate(tx2, 0))
+	require.NoError(t, pool.AddPrivate(tx1, 0))
+	require.NoError(t, pool.AddPrivate(tx0, 2))
+	assert.ErrorIs(t, pool.AddPrivate(tx0, 2), ErrAlreadyKnown)
+
+	assert.Nil(t, pool.Get(tx0.Hash()))
+	pending, queued := pool.Stats()
+	assert.Zero(t, pending+queued)
+	assert.Empty(t, txs)
+
+	// Replacements require a price bump
+	assert.ErrorIs(t, pool.AddPrivate(pricedTransaction(1, 90000, big.NewInt(1), key), 0), ErrReplaceUnderpriced)
+	tx1 = pricedTransaction(1, 100000, big.NewInt(2), key)
+	require.NoError(t, pool.AddPrivate(tx1, 0))
+
+	ev := <-events
+	require.Len(t, ev.Events, 1)
+	assert.Equal(t, TxEventReplaced, ev.Events[0].Reason)
+	assert.Equal(t, tx1.Hash(), *ev.Events[0].Replacement)
+
+	// Transactions are handed out sorted by nonce, up to their max block number
+	assert.Equal(t, types.Transactions{tx0, tx1, tx2}, pool.Private(2)[from])
+	assert.Equal(t, types.Transactions{tx1, tx2}, pool.Private(3)[from])
+
+	// Private transactions are dropped once included or expired
+	pool.chain.(*testBlockChain).statedb.SetNonce(from, 1)
+	<-pool.requestReset(nil, &types.Header{Number: big.NewInt(2), GasLimit: 10000000, BaseFee: big.NewInt(1)})
+
+	ev = <-events
+	require.Len(t, ev.Events, 1)
+	assert.Equal(t, tx0.Hash(), ev.Events[0].Hash)
+	assert.Equal(t, TxEventStale, ev.Events[0].Reason)
+	assert.Equal(t, map[common.Address]types.Transactions{from: {tx1, tx2}}, pool.Private(3))
+
+	<-pool.requestReset(nil, &types.Header{Number: big.NewInt(25), GasLimit: 10000000, BaseFee: big.NewInt(1)})
+	ev = <-events
+	require.Len(t, ev.Events, 2)
+	assert.Equal(t, TxEventExpired, ev.Events[0].Reason)
+	assert.Empty(t, pool.Private(26))
+}
+
+func TestPrivateTransactionPolicy(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+
+	// Private transactions are subject to the admission policy as well
+	require.NoError(t, pool.SetPolicy(&Policy{Deny: []common.Address{from}}))
+	assert.ErrorIs(t, pool.AddPrivate(pricedTransaction(0, 100000, big.NewInt(1), key), 0), ErrPolicyDenied)
+	assert.Empty(t, pool.Private(1))
+
+	require.NoError(t, pool.SetPolicy(nil))
+	assert.NoError(t, pool.AddPrivate(pricedTransaction(0, 100000, big.NewInt(1), key), 0))
+}
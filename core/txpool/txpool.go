@@ -30,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/prque"
 	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/mint"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
@@ -176,6 +177,10 @@ type Config struct {
 	GlobalQueue  uint64 // Maximum number of non-executable transaction slots for all accounts
 
 	Lifetime time.Duration // Maximum amount of time non-executable transaction are queued
+
+	PolicyFile string // JSON file with the per-address admission policy
+
+	PrivateLifetime uint64 // Number of blocks private transactions and bundles are kept for by default
 }
 
 // DefaultConfig contains the default configurations for the transaction
@@ -193,6 +198,8 @@ var DefaultConfig = Config{
 	GlobalQueue:  1024,
 
 	Lifetime: 3 * time.Hour,
+
+	PrivateLifetime: 25,
 }
 
 // sanitize checks the provided user configurations and changes anything that's
@@ -231,6 +238,10 @@ func (config *Config) sanitize() Config {
 		log.Warn("Sanitizing invalid txpool lifetime", "provided", conf.Lifetime, "updated", DefaultConfig.Lifetime)
 		conf.Lifetime = DefaultConfig.Lifetime
 	}
+	if conf.PrivateLifetime < 1 {
+		log.Warn("Sanitizing invalid txpool private lifetime", "provided", conf.PrivateLifetime, "updated", DefaultConfig.PrivateLifetime)
+		conf.PrivateLifetime = DefaultConfig.PrivateLifetime
+	}
 	return conf
 }
 
@@ -247,6 +258,7 @@ type TxPool struct {
 	chain       blockChain
 	gasPrice    *big.Int
 	txFeed      event.Feed
+	txEventFeed event.Feed
 	scope       event.SubscriptionScope
 	signer      types.Signer
 	mu          sync.RWMutex
@@ -256,6 +268,10 @@ type TxPool struct {
 	eip1559  atomic.Bool // Fork indicator whether we are using EIP-1559 type transactions.
 	shanghai atomic.Bool // Fork indicator whether we are in the Shanghai stage.
 
+	uniqueBurnTx atomic.Bool // Fork indicator whether minting the same burn tx twice is rejected.
+
+	policy atomic.Pointer[policyState] // Per-address admission policy, nil if not set
+
 	currentState  *state.StateDB // Current state in the blockchain head
 	pendingNonces *noncer        // Pending state tracking virtual nonces
 	currentMaxGas atomic.Uint64  // Current gas limit for transaction caps
@@ -267,6 +283,8 @@ type TxPool struct {
 	queue   map[common.Address]*list     // Queued but non-processable transactions
 	beats   map[common.Address]time.Time // Last heartbeat from each known account
 	all     *lookup                      // All transactions to allow lookups
+	private map[common.Hash]*privateTx   // Transactions kept off the network for local sealing
+	bundles []*Bundle                    // Bundles kept off the network for local sealing
 	priced  *pricedList                  // All transactions sorted by price
 
 	chainHeadCh     chan core.ChainHeadEvent
@@ -280,6 +298,9 @@ type TxPool struct {
 	initDoneCh      chan struct{}  // is closed once the pool is initialized (for tests)
 
 	changesSinceReorg int // A counter for how many drops we've performed in-between reorg.
+
+	txEvents []*TxEvent               // Transaction state transitions to post once the lock is released
+	included map[common.Hash]struct{} // Transactions included in the blocks added by the last reset
 }
 
 type txpoolResetRequest struct {
@@ -302,6 +323,7 @@ func NewTxPool(config Config, chainconfig *params.ChainConfig, chain blockChain)
 		queue:           make(map[common.Address]*list),
 		beats:           make(map[common.Address]time.Time),
 		all:             newLookup(),
+		private:         make(map[common.Hash]*privateTx),
 		chainHeadCh:     make(chan core.ChainHeadEvent, chainHeadChanSize),
 		reqResetCh:      make(chan *txpoolResetRequest),
 		reqPromoteCh:    make(chan *accountSet),
@@ -316,6 +338,13 @@ func NewTxPool(config Config, chainconfig *params.ChainConfig, chain blockChain)
 		log.Info("Setting new local account", "address", addr)
 		pool.locals.add(addr)
 	}
+	if config.PolicyFile != "" {
+		if err := pool.ReloadPolicy(); err != nil {
+			log.Error("Failed to load txpool policy", "err", err)
+		} else {
+			log.Info("Loaded txpool policy", "file", config.PolicyFile)
+		}
+	}
 	pool.priced = newPricedList(pool.all)
 	pool.reset(nil, chain.CurrentBlock())
 
@@ -402,12 +431,15 @@ func (pool *TxPool) loop() {
 				if time.Since(pool.beats[addr]) > pool.config.Lifetime {
 					list := pool.queue[addr].Flatten()
 					for _, tx := range list {
+						pool.recordTxEvent(tx, TxEventEvicted, nil)
 						pool.removeTx(tx.Hash(), true)
 					}
 					queuedEvictionMeter.Mark(int64(len(list)))
 				}
 			}
+			events := pool.takeTxEvents()
 			pool.mu.Unlock()
+			pool.sendTxEvents(events)
 
 		// Handle local transaction journal rotation
 		case <-journal.C:
@@ -455,8 +487,6 @@ func (pool *TxPool) GasPrice() *big.Int {
 // new transaction, and drops all transactions below this threshold.
 func (pool *TxPool) SetGasPrice(price *big.Int) {
 	pool.mu.Lock()
-	defer pool.mu.Unlock()
-
 	old := pool.gasPrice
 	pool.gasPrice = price
 	// if the min miner fee increased, remove transactions below the new threshold
@@ -464,10 +494,14 @@ func (pool *TxPool) SetGasPrice(price *big.Int) {
 		// pool.priced is sorted by GasFeeCap, so we have to iterate through pool.all instead
 		drop := pool.all.RemotesBelowTip(price)
 		for _, tx := range drop {
+			pool.recordTxEvent(tx, TxEventUnderpriced, nil)
 			pool.removeTx(tx.Hash(), false)
 		}
 		pool.priced.Removed(len(drop))
 	}
+	events := pool.takeTxEvents()
+	pool.mu.Unlock()
+	pool.sendTxEvents(events)
 
 	log.Info("Transaction pool price threshold updated", "price", price)
 }
@@ -682,6 +716,70 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 			return ErrOverdraft
 		}
 	}
+	// Mint instructions bound to fail would still burn the mint gas, reject them early
+	if instruction, ok := pool.mintInstruction(tx); ok {
+		return pool.validateMint(from, tx, instruction)
+	}
+	return nil
+}
+
+// mintInstruction returns input data of the transaction if it would be executed as
+// a mint instruction against the current state.
+func (pool *TxPool) mintInstruction(tx *types.Transaction) ([]byte, bool) {
+	data := tx.Data()
+	if tx.To() == nil || *tx.To() != mint.Contract.Address || len(data) != 65 {
+		return nil, false
+	}
+	return data, mint.IsDeployed(pool.currentState)
+}
+
+// validateMint checks whether a mint instruction could succeed against the current
+// state, taking into account mint instructions already pending from the same sender.
+func (pool *TxPool) validateMint(from common.Address, tx *types.Transaction, instruction []byte) error {
+	intrGas, err := core.IntrinsicGas(tx.Data(), tx.AccessList(), false, true, pool.istanbul.Load(), pool.shanghai.Load())
+	if err != nil {
+		return err
+	}
+	if tx.Gas() < intrGas+params.MintInstructionGas {
+		return fmt.Errorf("%w: mint instruction requires %d gas", core.ErrIntrinsicGas, intrGas+params.MintInstructionGas)
+	}
+
+	amount, burnTxHash, burnTxNetwork := new(big.Int).SetBytes(instruction[:32]), common.BytesToHash(instruction[32:64]), instruction[64]
+	if burnTxNetwork != mint.BurnNetworkEthereum && burnTxNetwork != mint.BurnNetworkTron {
+		return mint.ErrInvalidBurnNetwork
+	}
+
+	mintState := mint.ReadState(pool.currentState)
+	if from != mintState.Owner {
+		return mint.ErrSenderNotAllowed
+	}
+
+	uniqueBurnTx := pool.uniqueBurnTx.Load()
+	if uniqueBurnTx && mint.IsBurnTxUsed(pool.currentState, burnTxNetwork, burnTxHash) {
+		return mint.ErrBurnTxUsed
+	}
+
+	// Pending mint instructions are executed first, so they consume the limit in advance
+	sum := new(big.Int).Set(amount)
+	if list := pool.pending[from]; list != nil {
+		for _, pending := range list.txs.Flatten() {
+			if pending.Nonce() == tx.Nonce() {
+				continue // Replaced by this transaction
+			}
+			data, ok := pool.mintInstruction(pending)
+			if !ok {
+				continue
+			}
+			if uniqueBurnTx && data[64] == burnTxNetwork && common.BytesToHash(data[32:64]) == burnTxHash {
+				return mint.ErrBurnTxUsed
+			}
+			sum.Add(sum, new(big.Int).SetBytes(data[:32]))
+		}
+	}
+	if sum.Cmp(mintState.Limit) > 0 {
+		log.Trace("Pending mints would exceed mint limit", "sender", from, "limit", mintState.Limit, "required", sum)
+		return mint.ErrLimitExceeded
+	}
 	return nil
 }
 
@@ -768,6 +866,7 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (replaced bool, err e
 		for _, tx := range drop {
 			log.Trace("Discarding freshly underpriced transaction", "hash", tx.Hash(), "gasTipCap", tx.GasTipCap(), "gasFeeCap", tx.GasFeeCap())
 			underpricedTxMeter.Mark(1)
+			pool.recordTxEvent(tx, TxEventUnderpriced, nil)
 			dropped := pool.removeTx(tx.Hash(), false)
 			pool.changesSinceReorg += dropped
 		}
@@ -786,6 +885,7 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (replaced bool, err e
 			pool.all.Remove(old.Hash())
 			pool.priced.Removed(1)
 			pendingReplaceMeter.Mark(1)
+			pool.recordTxEvent(old, TxEventReplaced, tx)
 		}
 		pool.all.Add(tx, isLocal)
 		pool.priced.Put(tx, isLocal)
@@ -859,6 +959,7 @@ func (pool *TxPool) enqueueTx(hash common.Hash, tx *types.Transaction, local boo
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		queuedReplaceMeter.Mark(1)
+		pool.recordTxEvent(old, TxEventReplaced, tx)
 	} else {
 		// Nothing was replaced, bump the queued counter
 		queuedGauge.Inc(1)
@@ -908,6 +1009,7 @@ func (pool *TxPool) promoteTx(addr common.Address, hash common.Hash, tx *types.T
 		pool.all.Remove(hash)
 		pool.priced.Removed(1)
 		pendingDiscardMeter.Mark(1)
+		pool.recordTxEvent(tx, TxEventReplaced, list.txs.Get(tx.Nonce()))
 		return false
 	}
 	// Otherwise discard any previous transaction and mark this
@@ -915,6 +1017,7 @@ func (pool *TxPool) promoteTx(addr common.Address, hash common.Hash, tx *types.T
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		pendingReplaceMeter.Mark(1)
+		pool.recordTxEvent(old, TxEventReplaced, tx)
 	} else {
 		// Nothing was replaced, bump the pending counter
 		pendingGauge.Inc(1)
@@ -995,6 +1098,11 @@ func (pool *TxPool) addTxs(txs []*types.Transaction, local, sync bool) []error {
 			invalidTxMeter.Mark(1)
 			continue
 		}
+		if err := pool.checkPolicy(tx); err != nil {
+			errs[i] = err
+			policyTxMeter.Mark(1)
+			continue
+		}
 		// Accumulate all unknown transactions for deeper processing
 		news = append(news, tx)
 	}
@@ -1005,7 +1113,9 @@ func (pool *TxPool) addTxs(txs []*types.Transaction, local, sync bool) []error {
 	// Process all the new transaction and merge any errors into the original slice
 	pool.mu.Lock()
 	newErrs, dirtyAddrs := pool.addTxsLocked(news, local)
+	events := pool.takeTxEvents()
 	pool.mu.Unlock()
+	pool.sendTxEvents(events)
 
 	var nilSlot = 0
 	for _, err := range newErrs {
@@ -1100,6 +1210,7 @@ func (pool *TxPool) removeTx(hash common.Hash, outofbound bool) int {
 				delete(pool.pending, addr)
 			}
 			// Postpone any invalidated transactions
+			pool.recordTxEvents(invalids, TxEventDemoted)
 			for _, tx := range invalids {
 				// Internal shuffle shouldn't touch the lookup set.
 				pool.enqueueTx(tx.Hash(), tx, false, false)
@@ -1285,8 +1396,13 @@ func (pool *TxPool) runReorg(done chan struct{}, reset *txpoolResetRequest, dirt
 
 	dropBetweenReorgHistogram.Update(int64(pool.changesSinceReorg))
 	pool.changesSinceReorg = 0 // Reset change counter
+	txEvents := pool.takeTxEvents()
+	pool.included = nil
 	pool.mu.Unlock()
 
+	// Notify subsystems for transactions which left the pool or got demoted
+	pool.sendTxEvents(txEvents)
+
 	// Notify subsystems for newly added transactions
 	for _, tx := range promoted {
 		addr, _ := types.Sender(pool.signer, tx)
@@ -1308,7 +1424,7 @@ func (pool *TxPool) runReorg(done chan struct{}, reset *txpoolResetRequest, dirt
 // of the transaction pool is valid with regard to the chain state.
 func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	// If we're reorging an old state, reinject all dropped transactions
-	var reinject types.Transactions
+	var reinject, included types.Transactions
 
 	if oldHead != nil && oldHead.Hash() != newHead.ParentHash {
 		// If the reorg is too deep, avoid doing it (will happen during fast sync)
@@ -1319,7 +1435,7 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 			log.Debug("Skipping deep transaction reorg", "depth", depth)
 		} else {
 			// Reorg seems shallow enough to pull in all transactions into memory
-			var discarded, included types.Transactions
+			var discarded types.Transactions
 			var (
 				rem = pool.chain.GetBlock(oldHead.Hash(), oldHead.Number.Uint64())
 				add = pool.chain.GetBlock(newHead.Hash(), newHead.Number.Uint64())
@@ -1369,6 +1485,11 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 				reinject = types.TxDifference(discarded, included)
 			}
 		}
+	} else if oldHead != nil {
+		// A single block was added on top of the old head
+		if block := pool.chain.GetBlock(newHead.Hash(), newHead.Number.Uint64()); block != nil {
+			included = block.Transactions()
+		}
 	}
 	// Initialize the internal state to the current head
 	if newHead == nil {
@@ -1383,6 +1504,14 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	pool.pendingNonces = newNoncer(statedb)
 	pool.currentMaxGas.Store(newHead.GasLimit)
 
+	// Track the included transactions to tell them apart from the stale ones
+	pool.included = make(map[common.Hash]struct{}, len(included))
+	for _, tx := range included {
+		pool.included[tx.Hash()] = struct{}{}
+	}
+	pool.expirePrivate(newHead.Number.Uint64())
+	pool.expireBundles(newHead.Number.Uint64())
+
 	// Inject any transactions discarded due to reorgs
 	log.Debug("Reinjecting stale transactions", "count", len(reinject))
 	core.SenderCacher.Recover(pool.signer, reinject)
@@ -1394,6 +1523,7 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	pool.eip2718.Store(pool.chainconfig.IsBerlin(next))
 	pool.eip1559.Store(pool.chainconfig.IsLondon(next))
 	pool.shanghai.Store(pool.chainconfig.IsShanghai(uint64(time.Now().Unix())))
+	pool.uniqueBurnTx.Store(pool.chainconfig.IsUniqueBurnTx(next))
 }
 
 // promoteExecutables moves transactions that have become processable from the
@@ -1411,6 +1541,7 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) []*types.Trans
 		}
 		// Drop all transactions that are deemed too old (low nonce)
 		forwards := list.Forward(pool.currentState.GetNonce(addr))
+		pool.recordForwardedTxEvents(forwards)
 		for _, tx := range forwards {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
@@ -1418,6 +1549,7 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) []*types.Trans
 		log.Trace("Removed old queued transactions", "count", len(forwards))
 		// Drop all transactions that are too costly (low balance or out of gas)
 		drops, _ := list.Filter(pool.currentState.GetBalance(addr), pool.currentMaxGas.Load())
+		pool.recordTxEvents(drops, TxEventUnpayable)
 		for _, tx := range drops {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
@@ -1440,6 +1572,7 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) []*types.Trans
 		var caps types.Transactions
 		if !pool.locals.contains(addr) {
 			caps = list.Cap(int(pool.config.AccountQueue))
+			pool.recordTxEvents(caps, TxEventCapped)
 			for _, tx := range caps {
 				hash := tx.Hash()
 				pool.all.Remove(hash)
@@ -1501,6 +1634,7 @@ func (pool *TxPool) truncatePending() {
 					list := pool.pending[offenders[i]]
 
 					caps := list.Cap(list.Len() - 1)
+					pool.recordTxEvents(caps, TxEventCapped)
 					for _, tx := range caps {
 						// Drop the transaction from the global pools too
 						hash := tx.Hash()
@@ -1528,6 +1662,7 @@ func (pool *TxPool) truncatePending() {
 				list := pool.pending[addr]
 
 				caps := list.Cap(list.Len() - 1)
+				pool.recordTxEvents(caps, TxEventCapped)
 				for _, tx := range caps {
 					// Drop the transaction from the global pools too
 					hash := tx.Hash()
@@ -1578,6 +1713,7 @@ func (pool *TxPool) truncateQueue() {
 		// Drop all transactions if they are less than the overflow
 		if size := uint64(list.Len()); size <= drop {
 			for _, tx := range list.Flatten() {
+				pool.recordTxEvent(tx, TxEventCapped, nil)
 				pool.removeTx(tx.Hash(), true)
 			}
 			drop -= size
@@ -1587,6 +1723,7 @@ func (pool *TxPool) truncateQueue() {
 		// Otherwise drop only last few transactions
 		txs := list.Flatten()
 		for i := len(txs) - 1; i >= 0 && drop > 0; i-- {
+			pool.recordTxEvent(txs[i], TxEventCapped, nil)
 			pool.removeTx(txs[i].Hash(), true)
 			drop--
 			queuedRateLimitMeter.Mark(1)
@@ -1608,6 +1745,7 @@ func (pool *TxPool) demoteUnexecutables() {
 
 		// Drop all transactions that are deemed too old (low nonce)
 		olds := list.Forward(nonce)
+		pool.recordForwardedTxEvents(olds)
 		for _, tx := range olds {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
@@ -1615,6 +1753,8 @@ func (pool *TxPool) demoteUnexecutables() {
 		}
 		// Drop all transactions that are too costly (low balance or out of gas), and queue any invalids back for later
 		drops, invalids := list.Filter(pool.currentState.GetBalance(addr), pool.currentMaxGas.Load())
+		pool.recordTxEvents(drops, TxEventUnpayable)
+		pool.recordTxEvents(invalids, TxEventDemoted)
 		for _, tx := range drops {
 			hash := tx.Hash()
 			log.Trace("Removed unpayable pending transaction", "hash", hash)
@@ -1636,6 +1776,7 @@ func (pool *TxPool) demoteUnexecutables() {
 		// If there's a gap in front, alert (should never happen) and postpone all transactions
 		if list.Len() > 0 && list.txs.Get(nonce) == nil {
 			gapped := list.Cap(0)
+			pool.recordTxEvents(gapped, TxEventDemoted)
 			for _, tx := range gapped {
 				hash := tx.Hash()
 				log.Error("Demoting invalidated transaction", "hash", hash)
@@ -30,6 +30,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/mint"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -322,6 +323,59 @@ func TestInvalidTransactions(t *testing.T) {
 	}
 }
 
+func mintTransaction(nonce uint64, gaslimit uint64, amount *big.Int, burnTxHash common.Hash, burnTxNetwork byte, key *ecdsa.PrivateKey) *types.Transaction {
+	data := append(append(common.BigToHash(amount).Bytes(), burnTxHash.Bytes()...), burnTxNetwork)
+	tx, _ := types.SignTx(types.NewTransaction(nonce, mint.Contract.Address, new(big.Int), gaslimit, big.NewInt(1), data), types.HomesteadSigner{}, key)
+	return tx
+}
+
+func TestMintInstructions(t *testing.T) {
+	t.Parallel()
+
+	config := *params.TestChainConfig
+	config.MintContract = &params.MintContractConfig{UniqueBurnTxBlock: big.NewInt(0)}
+
+	pool, ownerKey := setupPoolWithConfig(&config)
+	defer pool.Stop()
+
+	owner := crypto.PubkeyToAddress(ownerKey.PublicKey)
+	otherKey, _ := crypto.GenerateKey()
+	other := crypto.PubkeyToAddress(otherKey.PublicKey)
+
+	pool.mu.Lock()
+	pool.currentState.SetCode(mint.Contract.Address, mint.Contract.Bytecode)
+	pool.currentState.SetState(mint.Contract.Address, mint.Contract.StorageLayout.Owner, owner.Hash())
+	pool.currentState.SetState(mint.Contract.Address, mint.Contract.StorageLayout.MintLimit, common.BigToHash(big.NewInt(100)))
+	pool.currentState.SetState(mint.Contract.Address, mint.BurnTxSlot(mint.BurnNetworkTron, common.HexToHash("0x01")), common.BytesToHash([]byte{1}))
+	pool.mu.Unlock()
+
+	testAddBalance(pool, owner, big.NewInt(params.Ether))
+	testAddBalance(pool, other, big.NewInt(params.Ether))
+
+	tests := []struct {
+		tx   *types.Transaction
+		want error
+	}{
+		{mintTransaction(0, 50000, big.NewInt(60), common.HexToHash("0x02"), mint.BurnNetworkTron, ownerKey), core.ErrIntrinsicGas},
+		{mintTransaction(0, 130000, big.NewInt(60), common.HexToHash("0x02"), 2, ownerKey), mint.ErrInvalidBurnNetwork},
+		{mintTransaction(0, 130000, big.NewInt(60), common.HexToHash("0x02"), mint.BurnNetworkTron, otherKey), mint.ErrSenderNotAllowed},
+		{mintTransaction(0, 130000, big.NewInt(101), common.HexToHash("0x02"), mint.BurnNetworkTron, ownerKey), mint.ErrLimitExceeded},
+		{mintTransaction(0, 130000, big.NewInt(60), common.HexToHash("0x01"), mint.BurnNetworkTron, ownerKey), mint.ErrBurnTxUsed},
+		{mintTransaction(0, 130000, big.NewInt(60), common.HexToHash("0x01"), mint.BurnNetworkEthereum, ownerKey), nil},
+		// Pending mints are taken into account
+		{mintTransaction(1, 130000, big.NewInt(60), common.HexToHash("0x02"), mint.BurnNetworkTron, ownerKey), mint.ErrLimitExceeded},
+		{mintTransaction(1, 130000, big.NewInt(10), common.HexToHash("0x01"), mint.BurnNetworkEthereum, ownerKey), mint.ErrBurnTxUsed},
+		{mintTransaction(1, 130000, big.NewInt(40), common.HexToHash("0x02"), mint.BurnNetworkTron, ownerKey), nil},
+		// Replacement is validated without the amount of the replaced mint, so only the price bump fails
+		{mintTransaction(1, 130000, big.NewInt(40), common.HexToHash("0x03"), mint.BurnNetworkTron, ownerKey), ErrReplaceUnderpriced},
+	}
+	for i, test := range tests {
+		if err := pool.AddRemotesSync([]*types.Transaction{test.tx})[0]; !errors.Is(err, test.want) {
+			t.Errorf("test %d: want %v have %v", i, test.want, err)
+		}
+	}
+}
+
 func TestQueue(t *testing.T) {
 	t.Parallel()
 
@@ -17,7 +17,6 @@
 package vm
 
 import (
-	"errors"
 	"math/big"
 	"sync/atomic"
 
@@ -532,7 +531,7 @@ func (evm *EVM) Create2(caller ContractRef, code []byte, gas uint64, endowment *
 func (evm *EVM) IsMintInstruction(receiver common.Address, data []byte) bool {
 	return len(data) == 65 &&
 		receiver == mint.Contract.Address &&
-		evm.StateDB.GetCodeHash(mint.Contract.Address) == mint.Contract.BytecodeHash
+		mint.IsDeployed(evm.StateDB)
 }
 
 // Mint executes mint instruction.
@@ -560,15 +559,20 @@ func (evm *EVM) IsMintInstruction(receiver common.Address, data []byte) bool {
 //   - there is enough gas for execution;
 //   - input data is valid;
 //   - transaction sender equals to mint contract owner;
-//   - specified mint amount would not exceed mint limit.
+//   - specified mint amount would not exceed mint limit;
+//   - specified burn tx has not been minted yet (enforced since unique burn tx fork only).
 //
 // If all conditions are met, then state will be changed as following:
 //   - mint limit will be decreased by mint amount in mint state contract;
+//   - burn tx will be marked as minted in mint state contract (since unique burn tx fork only);
 //   - sender balance will be increased by mint amount;
 //   - Mint event will be produced.
 //
 // Otherwise, VM error will be returned and state would not change (except sender's nonce).
 func (evm *EVM) Mint(sender common.Address, data [65]byte, gas uint64) (leftOverGas uint64, err error) {
+	// Nonce is increased before tracing starts, as it is done for regular calls
+	evm.StateDB.SetNonce(sender, evm.StateDB.GetNonce(sender)+1)
+
 	if evm.Config.Tracer != nil {
 		evm.Config.Tracer.CaptureStart(evm, sender, mint.Contract.Address, false, data[:], gas, new(big.Int))
 		defer func() {
@@ -576,8 +580,6 @@ func (evm *EVM) Mint(sender common.Address, data [65]byte, gas uint64) (leftOver
 		}()
 	}
 
-	evm.StateDB.SetNonce(sender, evm.StateDB.GetNonce(sender)+1)
-
 	if gas < params.MintInstructionGas {
 		return 0, ErrOutOfGas
 	}
@@ -586,36 +588,42 @@ func (evm *EVM) Mint(sender common.Address, data [65]byte, gas uint64) (leftOver
 
 	amountBytes, burnTxHashBytes, burnTxNetwork := data[:32], data[32:64], data[64]
 	if burnTxNetwork != mint.BurnNetworkEthereum && burnTxNetwork != mint.BurnNetworkTron {
-		return leftOverGas, errors.New("invalid burn tx network in mint instruction")
+		return leftOverGas, mint.ErrInvalidBurnNetwork
 	}
 
-	contractOwnerBytes := evm.getMintState(mint.Contract.StorageLayout.Owner).Bytes()
-	contractOwner := common.BytesToAddress(contractOwnerBytes)
-	if sender != contractOwner {
-		return leftOverGas, errors.New("transaction sender is not allowed to mint")
+	mintState := mint.ReadState(evm.StateDB)
+	if sender != mintState.Owner {
+		return leftOverGas, mint.ErrSenderNotAllowed
 	}
 
 	amount := new(big.Int).SetBytes(amountBytes)
-	mintLimit := evm.getMintState(mint.Contract.StorageLayout.MintLimit).Big()
-	if amount.Cmp(mintLimit) == 1 {
-		return leftOverGas, errors.New("mint amount exceeds mint limit")
+	if amount.Cmp(mintState.Limit) == 1 {
+		return leftOverGas, mint.ErrLimitExceeded
 	}
 
-	nextLimit := new(big.Int).Sub(mintLimit, amount)
+	burnTxHash := common.BytesToHash(burnTxHashBytes)
+	uniqueBurnTx := evm.chainRules.IsUniqueBurnTx
+	if uniqueBurnTx && mint.IsBurnTxUsed(evm.StateDB, burnTxNetwork, burnTxHash) {
+		return leftOverGas, mint.ErrBurnTxUsed
+	}
+
+	nextLimit := new(big.Int).Sub(mintState.Limit, amount)
 	nextLimitHash := common.BytesToHash(nextLimit.Bytes())
 
 	evm.StateDB.SetState(mint.Contract.Address, mint.Contract.StorageLayout.MintLimit, nextLimitHash)
 	evm.StateDB.AddBalance(sender, amount)
+	if uniqueBurnTx {
+		evm.StateDB.SetState(mint.Contract.Address, mint.BurnTxSlot(burnTxNetwork, burnTxHash), common.BytesToHash([]byte{1}))
+	}
 
-	event := mint.EventAbi.Events[mint.EventName]
-	logData, packErr := event.Inputs.Pack(amount, common.BytesToHash(burnTxHashBytes), burnTxNetwork)
+	logData, packErr := mint.PackEvent(amount, burnTxHash, burnTxNetwork)
 	if packErr != nil {
 		log.Crit("failed to pack Mint event data", "error", packErr)
 	}
 
 	evm.StateDB.AddLog(&types.Log{
 		Address:     mint.Contract.Address,
-		Topics:      []common.Hash{event.ID},
+		Topics:      []common.Hash{mint.EventID()},
 		Data:        logData,
 		BlockNumber: evm.Context.BlockNumber.Uint64(),
 	})
@@ -623,9 +631,5 @@ func (evm *EVM) Mint(sender common.Address, data [65]byte, gas uint64) (leftOver
 	return leftOverGas, nil
 }
 
-func (evm *EVM) getMintState(slotId common.Hash) common.Hash {
-	return evm.StateDB.GetState(mint.Contract.Address, slotId)
-}
-
 // ChainConfig returns the environment's chain configuration
 func (evm *EVM) ChainConfig() *params.ChainConfig { return evm.chainConfig }
@@ -33,6 +33,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/log"
@@ -243,6 +244,31 @@ func (api *AdminAPI) ImportChain(file string) (bool, error) {
 	return true, nil
 }
 
+// TxPoolAdminAPI is the collection of APIs for administering the transaction
+// pool admission policy.
+type TxPoolAdminAPI struct {
+	eth *Ethereum
+}
+
+// NewTxPoolAdminAPI creates a new instance of TxPoolAdminAPI.
+func NewTxPoolAdminAPI(eth *Ethereum) *TxPoolAdminAPI {
+	return &TxPoolAdminAPI{eth: eth}
+}
+
+// SetPolicy replaces the admission policy of the transaction pool. If no policy
+// is given, the policy file configured with --txpool.policy is reloaded.
+func (api *TxPoolAdminAPI) SetPolicy(policy *txpool.Policy) error {
+	if policy == nil {
+		return api.eth.TxPool().ReloadPolicy()
+	}
+	return api.eth.TxPool().SetPolicy(policy)
+}
+
+// Policy returns the admission policy currently enforced by the transaction pool.
+func (api *TxPoolAdminAPI) Policy() *txpool.Policy {
+	return api.eth.TxPool().Policy()
+}
+
 // DebugAPI is the collection of Ethereum full node APIs for debugging the
 // protocol.
 type DebugAPI struct {
@@ -75,7 +75,7 @@ func (b *EthAPIBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumb
 		return b.eth.blockchain.CurrentBlock(), nil
 	}
 	if number == rpc.FinalizedBlockNumber {
-		if !b.eth.Merger().TDDReached() {
+		if !b.eth.Merger().TDDReached() && b.eth.attestations == nil {
 			return nil, errors.New("'finalized' tag not supported on pre-merge network")
 		}
 		block := b.eth.blockchain.CurrentFinalBlock()
@@ -130,10 +130,13 @@ func (b *EthAPIBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumbe
 		return b.eth.blockchain.GetBlock(header.Hash(), header.Number.Uint64()), nil
 	}
 	if number == rpc.FinalizedBlockNumber {
-		if !b.eth.Merger().TDDReached() {
+		if !b.eth.Merger().TDDReached() && b.eth.attestations == nil {
 			return nil, errors.New("'finalized' tag not supported on pre-merge network")
 		}
 		header := b.eth.blockchain.CurrentFinalBlock()
+		if header == nil {
+			return nil, errors.New("finalized block not found")
+		}
 		return b.eth.blockchain.GetBlock(header.Hash(), header.Number.Uint64()), nil
 	}
 	if number == rpc.SafeBlockNumber {
@@ -277,6 +280,14 @@ func (b *EthAPIBackend) SendTx(ctx context.Context, signedTx *types.Transaction)
 	return b.eth.txPool.AddLocal(signedTx)
 }
 
+func (b *EthAPIBackend) SendPrivateTx(ctx context.Context, signedTx *types.Transaction, maxBlock uint64) error {
+	return b.eth.txPool.AddPrivate(signedTx, maxBlock)
+}
+
+func (b *EthAPIBackend) SendBundle(ctx context.Context, bundle *txpool.Bundle) error {
+	return b.eth.txPool.AddBundle(bundle)
+}
+
 func (b *EthAPIBackend) GetPoolTransactions() (types.Transactions, error) {
 	pending := b.eth.txPool.Pending(false)
 	var txs types.Transactions
@@ -319,6 +330,10 @@ func (b *EthAPIBackend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.S
 	return b.eth.TxPool().SubscribeNewTxsEvent(ch)
 }
 
+func (b *EthAPIBackend) SubscribeTxPoolEvents(ch chan<- txpool.TxEventsEvent) event.Subscription {
+	return b.eth.TxPool().SubscribeTxEvents(ch)
+}
+
 func (b *EthAPIBackend) SyncProgress() ethereum.SyncProgress {
 	return b.eth.Downloader().Progress()
 }
@@ -368,6 +383,21 @@ func (b *EthAPIBackend) BloomStatus() (uint64, uint64) {
 	return params.BloomBitsBlocks, sections
 }
 
+func (b *EthAPIBackend) LogIndexStatus() (uint64, uint64, uint64) {
+	if b.eth.logIndexer == nil {
+		return 0, 0, 0
+	}
+	first, sections := core.LogIndexSections(b.eth.chainDb, b.eth.logIndexer)
+	return params.BloomBitsBlocks, first, sections
+}
+
+func (b *EthAPIBackend) LogIndexBlocks(ctx context.Context, section uint64, item []byte) ([]uint64, error) {
+	if b.eth.logIndexer == nil {
+		return nil, errors.New("log index disabled")
+	}
+	return core.ReadLogIndex(b.eth.chainDb, b.eth.logIndexer, section, item)
+}
+
 func (b *EthAPIBackend) ServiceFilter(ctx context.Context, session *bloombits.MatcherSession) {
 	for i := 0; i < bloomFilterThreads; i++ {
 		go session.Multiplex(bloomRetrievalBatch, bloomRetrievalWait, b.eth.bloomRequests)
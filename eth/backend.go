@@ -41,6 +41,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/eth/protocols/attest"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/eth/protocols/snap"
 	"github.com/ethereum/go-ethereum/ethdb"
@@ -73,6 +74,7 @@ type Ethereum struct {
 	ethDialCandidates  enode.Iterator
 	snapDialCandidates enode.Iterator
 	merger             *consensus.Merger
+	attestations       *attest.Pool
 
 	// DB interfaces
 	chainDb ethdb.Database // Block chain database
@@ -84,6 +86,7 @@ type Ethereum struct {
 	bloomRequests     chan chan *bloombits.Retrieval // Channel receiving bloom data retrieval requests
 	bloomIndexer      *core.ChainIndexer             // Bloom indexer operating during block imports
 	closeBloomHandler chan struct{}
+	logIndexer        *core.ChainIndexer // Exact log indexer operating during block imports, nil if disabled
 
 	APIBackend *EthAPIBackend
 
@@ -203,6 +206,10 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		return nil, err
 	}
 	eth.bloomIndexer.Start(eth.blockchain)
+	if config.LogIndex {
+		eth.logIndexer = core.NewLogIndexer(chainDb, params.BloomBitsBlocks, params.BloomConfirms, config.LogIndexHistory)
+		eth.logIndexer.Start(eth.blockchain)
+	}
 
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = stack.ResolvePath(config.TxPool.Journal)
@@ -215,6 +222,13 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if checkpoint == nil {
 		checkpoint = params.TrustedCheckpoints[eth.blockchain.Genesis().Hash()]
 	}
+	if config.CliqueAttestations {
+		if engine := cliqueEngine(eth.engine); engine != nil {
+			eth.attestations = attest.NewPool(chainDb, eth.blockchain, engine)
+		} else {
+			log.Warn("Clique attestations requested on a non-clique network")
+		}
+	}
 	if eth.handler, err = newHandler(&handlerConfig{
 		Database:       chainDb,
 		Chain:          eth.blockchain,
@@ -226,6 +240,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		EventMux:       eth.eventMux,
 		Checkpoint:     checkpoint,
 		RequiredBlocks: config.RequiredBlocks,
+		Attestations:   eth.attestations,
 	}); err != nil {
 		return nil, err
 	}
@@ -307,6 +322,12 @@ func (s *Ethereum) APIs() []rpc.API {
 		}, {
 			Namespace: "admin",
 			Service:   NewAdminAPI(s),
+		}, {
+			// Administrative txpool methods are never served on the public
+			// HTTP and WS endpoints, only over IPC and the authenticated ones.
+			Namespace:     "txpool",
+			Service:       NewTxPoolAdminAPI(s),
+			Authenticated: true,
 		}, {
 			Namespace: "debug",
 			Service:   NewDebugAPI(s),
@@ -386,6 +407,19 @@ func (s *Ethereum) shouldPreserve(header *types.Header) bool {
 	return s.isLocalBlock(header)
 }
 
+// cliqueEngine returns the clique engine the given engine is or wraps, if any.
+func cliqueEngine(engine consensus.Engine) *clique.Clique {
+	if c, ok := engine.(*clique.Clique); ok {
+		return c
+	}
+	if cl, ok := engine.(*beacon.Beacon); ok {
+		if c, ok := cl.InnerEngine().(*clique.Clique); ok {
+			return c
+		}
+	}
+	return nil
+}
+
 // SetEtherbase sets the mining reward address.
 func (s *Ethereum) SetEtherbase(etherbase common.Address) {
 	s.lock.Lock()
@@ -424,15 +458,7 @@ func (s *Ethereum) StartMining(threads int) error {
 			log.Error("Cannot start mining without etherbase", "err", err)
 			return fmt.Errorf("etherbase missing: %v", err)
 		}
-		var cli *clique.Clique
-		if c, ok := s.engine.(*clique.Clique); ok {
-			cli = c
-		} else if cl, ok := s.engine.(*beacon.Beacon); ok {
-			if c, ok := cl.InnerEngine().(*clique.Clique); ok {
-				cli = c
-			}
-		}
-		if cli != nil {
+		if cli := cliqueEngine(s.engine); cli != nil {
 			wallet, err := s.accountManager.Find(accounts.Account{Address: eb})
 			if wallet == nil || err != nil {
 				log.Error("Etherbase account unavailable locally", "err", err)
@@ -491,6 +517,9 @@ func (s *Ethereum) Protocols() []p2p.Protocol {
 	if s.config.SnapshotCache > 0 {
 		protos = append(protos, snap.MakeProtocols((*snapHandler)(s.handler), s.snapDialCandidates)...)
 	}
+	if s.attestations != nil {
+		protos = append(protos, attest.MakeProtocols((*attestHandler)(s.handler))...)
+	}
 	return protos
 }
 
@@ -515,6 +544,10 @@ func (s *Ethereum) Start() error {
 	}
 	// Start the networking layer and the light server if requested
 	s.handler.Start(maxPeers)
+
+	if s.attestations != nil {
+		s.attestations.Start()
+	}
 	return nil
 }
 
@@ -525,10 +558,16 @@ func (s *Ethereum) Stop() error {
 	s.ethDialCandidates.Close()
 	s.snapDialCandidates.Close()
 	s.handler.Stop()
+	if s.attestations != nil {
+		s.attestations.Stop()
+	}
 
 	// Then stop everything else.
 	s.bloomIndexer.Close()
 	close(s.closeBloomHandler)
+	if s.logIndexer != nil {
+		s.logIndexer.Close()
+	}
 	s.txPool.Stop()
 	s.miner.Close()
 	s.blockchain.Stop()
@@ -135,6 +135,9 @@ type Config struct {
 
 	TxLookupLimit uint64 `toml:",omitempty"` // The maximum number of blocks from head whose tx indices are reserved.
 
+	LogIndex        bool   `toml:",omitempty"` // Whether to maintain the exact address and topic index of logs
+	LogIndexHistory uint64 `toml:",omitempty"` // The number of recent sections of the log index kept, 0 for all
+
 	// RequiredBlocks is a set of block number -> hash mappings which must be in the
 	// canonical chain of all remote peers. Setting the option makes geth verify the
 	// presence of these blocks for every new peer connection.
@@ -171,6 +174,14 @@ type Config struct {
 	// This is the number of blocks for which logs will be cached in the filter system.
 	FilterLogCacheSize int
 
+	// FilterBlockRange is the maximum number of blocks a log query may span,
+	// zero for no limit.
+	FilterBlockRange uint64
+
+	// FilterMaxResults is the maximum number of logs a log query may return,
+	// zero for no limit.
+	FilterMaxResults int
+
 	// Mining options
 	Miner miner.Config
 
@@ -186,6 +197,10 @@ type Config struct {
 	// Enables tracking of SHA3 preimages in the VM
 	EnablePreimageRecording bool
 
+	// Enables finalizing clique blocks with quorum certificates of signer
+	// attestations exchanged over the `attest` protocol
+	CliqueAttestations bool `toml:",omitempty"`
+
 	// Miscellaneous options
 	DocRoot string `toml:"-"`
 
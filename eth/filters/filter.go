@@ -19,7 +19,9 @@ package filters
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/bloombits"
@@ -27,6 +29,16 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+var (
+	// errBlockRangeTooLarge is returned if a log query spans more blocks than
+	// allowed by the filter system.
+	errBlockRangeTooLarge = errors.New("block range too large")
+
+	// errTooManyLogs is returned if a log query matches more logs than allowed
+	// by the filter system.
+	errTooManyLogs = errors.New("query returned too many results")
+)
+
 // Filter can be used to retrieve and filter logs.
 type Filter struct {
 	sys *FilterSystem
@@ -37,6 +49,11 @@ type Filter struct {
 	block      *common.Hash // Block hash if filtering a single block
 	begin, end int64        // Range interval if filtering multiple blocks
 
+	maxBlocks  uint64 // Maximum number of blocks in the range, zero for no limit
+	maxResults int    // Maximum number of logs returned, zero for no limit
+	stopAfter  int    // Number of logs after which the search ends with the current block, zero for no limit
+	found      int    // Number of logs found so far
+
 	matcher *bloombits.Matcher
 }
 
@@ -86,12 +103,27 @@ func (sys *FilterSystem) NewBlockFilter(block common.Hash, addresses []common.Ad
 // or based on range queries. The search criteria needs to be explicitly set.
 func newFilter(sys *FilterSystem, addresses []common.Address, topics [][]common.Hash) *Filter {
 	return &Filter{
-		sys:       sys,
-		addresses: addresses,
-		topics:    topics,
+		sys:        sys,
+		addresses:  addresses,
+		topics:     topics,
+		maxBlocks:  sys.cfg.BlockRange,
+		maxResults: sys.cfg.MaxResults,
 	}
 }
 
+// checkResults returns an error if the number of logs found exceeds the limit.
+func (f *Filter) checkResults(found int) error {
+	if f.maxResults > 0 && found > f.maxResults {
+		return fmt.Errorf("%w: more than %d logs", errTooManyLogs, f.maxResults)
+	}
+	return nil
+}
+
+// full returns whether enough logs were found to end the search.
+func (f *Filter) full() bool {
+	return f.stopAfter > 0 && f.found >= f.stopAfter
+}
+
 // Logs searches the blockchain for matching log entries, returning all from the
 // first block that contains matches, updating the start of the filter accordingly.
 func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
@@ -104,7 +136,11 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 		if header == nil {
 			return nil, errors.New("unknown block")
 		}
-		return f.blockLogs(ctx, header)
+		logs, err := f.blockLogs(ctx, header)
+		if err != nil {
+			return nil, err
+		}
+		return logs, f.checkResults(len(logs))
 	}
 	// Short-cut if all we care about is pending logs
 	if f.begin == rpc.PendingBlockNumber.Int64() {
@@ -153,24 +189,27 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 	if f.end, err = resolveSpecial(f.end); err != nil {
 		return nil, err
 	}
-	// Gather all indexed logs, and finish with non indexed ones
+	if f.maxBlocks > 0 && f.end >= f.begin && uint64(f.end-f.begin) >= f.maxBlocks {
+		return nil, fmt.Errorf("%w: %d blocks, max %d", errBlockRangeTooLarge, f.end-f.begin+1, f.maxBlocks)
+	}
+	// Gather all exactly indexed logs, then bloom indexed ones, and finish with
+	// non indexed ones
 	var (
-		logs           []*types.Log
-		end            = uint64(f.end)
-		size, sections = f.sys.backend.BloomStatus()
+		logs []*types.Log
+		end  = uint64(f.end)
 	)
-	if indexed := sections * size; indexed > uint64(f.begin) {
-		if indexed > end {
-			logs, err = f.indexedLogs(ctx, end)
-		} else {
-			logs, err = f.indexedLogs(ctx, indexed-1)
+	if index, ok := f.sys.backend.(LogIndexBackend); ok && (len(f.addresses) > 0 || f.firstTopics() != nil) {
+		if logs, err = f.exactLogs(ctx, index, end); err != nil {
+			return logs, err
 		}
+	}
+	if !f.full() {
+		rest, err := f.bloomLogs(ctx, end)
+		logs = append(logs, rest...)
 		if err != nil {
 			return logs, err
 		}
 	}
-	rest, err := f.unindexedLogs(ctx, end)
-	logs = append(logs, rest...)
 	if pending {
 		pendingLogs, err := f.pendingLogs()
 		if err != nil {
@@ -178,7 +217,125 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 		}
 		logs = append(logs, pendingLogs...)
 	}
-	return logs, err
+	return logs, f.checkResults(len(logs))
+}
+
+// firstTopics returns the first topics matched by the filter, nil if any.
+func (f *Filter) firstTopics() []common.Hash {
+	if len(f.topics) == 0 || len(f.topics[0]) == 0 {
+		return nil
+	}
+	return f.topics[0]
+}
+
+// exactLogs returns the logs matching the filter criteria up to the end of the
+// exact address and topic index, if the range overlaps it. The blocks preceding
+// the index are searched based on the bloom bits.
+func (f *Filter) exactLogs(ctx context.Context, index LogIndexBackend, end uint64) ([]*types.Log, error) {
+	size, first, sections := index.LogIndexStatus()
+	if size == 0 || first >= sections || uint64(f.begin) > end || uint64(f.begin) >= sections*size || end < first*size {
+		return nil, nil
+	}
+	if last := sections*size - 1; end > last {
+		end = last
+	}
+	var logs []*types.Log
+	if uint64(f.begin) < first*size {
+		found, err := f.bloomLogs(ctx, first*size-1)
+		if err != nil || f.full() {
+			return found, err
+		}
+		logs = found
+	}
+	// Every criterion matches the union of the blocks of its items, the blocks
+	// matching all criteria are checked
+	var criteria [][][]byte
+	if len(f.addresses) > 0 {
+		items := make([][]byte, len(f.addresses))
+		for i, address := range f.addresses {
+			items[i] = address.Bytes()
+		}
+		criteria = append(criteria, items)
+	}
+	if topics := f.firstTopics(); topics != nil {
+		items := make([][]byte, len(topics))
+		for i, topic := range topics {
+			items[i] = topic.Bytes()
+		}
+		criteria = append(criteria, items)
+	}
+	for section := uint64(f.begin) / size; section <= end/size; section++ {
+		if err := ctx.Err(); err != nil {
+			return logs, err
+		}
+		var matches map[uint64]bool
+		for _, items := range criteria {
+			union := make(map[uint64]bool)
+			for _, item := range items {
+				blocks, err := index.LogIndexBlocks(ctx, section, item)
+				if err != nil {
+					return logs, err
+				}
+				for _, number := range blocks {
+					if matches == nil || matches[number] {
+						union[number] = true
+					}
+				}
+			}
+			matches = union
+		}
+		numbers := make([]uint64, 0, len(matches))
+		for number := range matches {
+			if number >= uint64(f.begin) && number <= end {
+				numbers = append(numbers, number)
+			}
+		}
+		sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+
+		for _, number := range numbers {
+			header, err := f.sys.backend.HeaderByNumber(ctx, rpc.BlockNumber(number))
+			if header == nil || err != nil {
+				return logs, err
+			}
+			found, err := f.checkMatches(ctx, header)
+			if err != nil {
+				return logs, err
+			}
+			f.begin = int64(number) + 1
+			logs = append(logs, found...)
+			if err := f.checkResults(len(logs)); err != nil {
+				return logs, err
+			}
+			if f.found += len(found); f.full() {
+				return logs, nil
+			}
+		}
+	}
+	f.begin = int64(end) + 1
+	return logs, nil
+}
+
+// bloomLogs returns the logs matching the filter criteria up to the given end,
+// based on the bloom bits index as far as available and on raw block iteration
+// beyond.
+func (f *Filter) bloomLogs(ctx context.Context, end uint64) ([]*types.Log, error) {
+	var (
+		logs           []*types.Log
+		err            error
+		size, sections = f.sys.backend.BloomStatus()
+	)
+	if indexed := sections * size; indexed > uint64(f.begin) {
+		if indexed > end {
+			logs, err = f.indexedLogs(ctx, end)
+		} else {
+			logs, err = f.indexedLogs(ctx, indexed-1)
+		}
+		if err != nil || f.full() {
+			return logs, err
+		}
+	}
+	rest, err := f.unindexedLogs(ctx, end)
+	return append(logs, rest...), err
 }
 
 // indexedLogs returns the logs matching the filter criteria based on the bloom
@@ -221,6 +378,12 @@ func (f *Filter) indexedLogs(ctx context.Context, end uint64) ([]*types.Log, err
 				return logs, err
 			}
 			logs = append(logs, found...)
+			if err := f.checkResults(len(logs)); err != nil {
+				return logs, err
+			}
+			if f.found += len(found); f.full() {
+				return logs, nil
+			}
 
 		case <-ctx.Done():
 			return logs, ctx.Err()
@@ -246,6 +409,13 @@ func (f *Filter) unindexedLogs(ctx context.Context, end uint64) ([]*types.Log, e
 			return logs, err
 		}
 		logs = append(logs, found...)
+		if err := f.checkResults(len(logs)); err != nil {
+			return logs, err
+		}
+		if f.found += len(found); f.full() {
+			f.begin++
+			return logs, nil
+		}
 	}
 	return logs, nil
 }
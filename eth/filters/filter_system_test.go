@@ -33,6 +33,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
@@ -46,6 +47,7 @@ type testBackend struct {
 	db              ethdb.Database
 	sections        uint64
 	txFeed          event.Feed
+	txEventsFeed    event.Feed
 	logsFeed        event.Feed
 	rmLogsFeed      event.Feed
 	pendingLogsFeed event.Feed
@@ -129,6 +131,10 @@ func (b *testBackend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Sub
 	return b.txFeed.Subscribe(ch)
 }
 
+func (b *testBackend) SubscribeTxPoolEvents(ch chan<- txpool.TxEventsEvent) event.Subscription {
+	return b.txEventsFeed.Subscribe(ch)
+}
+
 func (b *testBackend) SubscribeRemovedLogsEvent(ch chan<- core.RemovedLogsEvent) event.Subscription {
 	return b.rmLogsFeed.Subscribe(ch)
 }
@@ -354,6 +360,42 @@ func TestPendingTxFilterFullTx(t *testing.T) {
 	}
 }
 
+// TestTxPoolEventsSubscription tests whether the transaction pool events are
+// delivered to the subscribers.
+func TestTxPoolEventsSubscription(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db           = rawdb.NewMemoryDatabase()
+		backend, sys = newTestFilterSystem(t, db, Config{})
+		api          = NewFilterAPI(sys, false)
+		from         = common.HexToAddress("0xb794f5ea0ba39494ce83a213fffba74279579268")
+
+		events = []*txpool.TxEvent{
+			{Hash: common.Hash{1}, From: from, Reason: txpool.TxEventReplaced, Replacement: &common.Hash{2}},
+			{Hash: common.Hash{3}, Reason: txpool.TxEventEvicted},
+		}
+	)
+	ch := make(chan []*txpool.TxEvent)
+	sub := api.events.SubscribeTxPoolEvents(ch)
+	defer sub.Unsubscribe()
+
+	backend.txEventsFeed.Send(txpool.TxEventsEvent{Events: events})
+	select {
+	case have := <-ch:
+		if !reflect.DeepEqual(have, events) {
+			t.Fatalf("event mismatch: have %v, want %v", have, events)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("event timeout")
+	}
+
+	crit := &TxEventCriteria{Addresses: []common.Address{from}, Reasons: []txpool.TxEventReason{txpool.TxEventReplaced}}
+	if !crit.matches(events[0]) || crit.matches(events[1]) {
+		t.Fatal("criteria mismatch")
+	}
+}
+
 // TestLogFilterCreation test whether a given filter criteria makes sense.
 // If not it must return an error.
 func TestLogFilterCreation(t *testing.T) {
@@ -0,0 +1,148 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package filters
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// logIndexTestBackend is a test backend serving an exact log index of the
+// sections in [first, sections).
+type logIndexTestBackend struct {
+	*testBackend
+	size, first, sections uint64
+	index                 map[string][]uint64
+}
+
+func (b *logIndexTestBackend) LogIndexStatus() (uint64, uint64, uint64) {
+	return b.size, b.first, b.sections
+}
+
+func (b *logIndexTestBackend) LogIndexBlocks(ctx context.Context, section uint64, item []byte) ([]uint64, error) {
+	var blocks []uint64
+	for _, number := range b.index[string(item)] {
+		if number/b.size == section {
+			blocks = append(blocks, number)
+		}
+	}
+	return blocks, nil
+}
+
+func TestExactLogIndex(t *testing.T) {
+	var (
+		db      = rawdb.NewMemoryDatabase()
+		plain   = &testBackend{db: db}
+		backend = &logIndexTestBackend{testBackend: plain, size: 8, first: 1, sections: 2, index: make(map[string][]uint64)}
+		sys     = NewFilterSystem(backend, Config{})
+		config  = *params.TestChainConfig
+		gspec   = &core.Genesis{Config: &config, BaseFee: big.NewInt(params.InitialBaseFee)}
+		addrs   = []common.Address{common.HexToAddress("0x0a"), common.HexToAddress("0x0b"), common.HexToAddress("0x0c")}
+		topics  = []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")}
+	)
+	config.CepheusBlock = big.NewInt(0)
+
+	// Every block contains logs of various addresses and topics
+	_, chain, receipts := core.GenerateChainWithGenesis(gspec, ethash.NewFaker(), 20, func(i int, gen *core.BlockGen) {
+		receipt := types.NewReceipt(nil, false, 0)
+		for j := 0; j <= i%3; j++ {
+			receipt.Logs = append(receipt.Logs, &types.Log{
+				Address: addrs[(i+j)%3],
+				Topics:  []common.Hash{topics[(i+2*j)%3], topics[i%2]},
+			})
+		}
+		receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+		gen.AddUncheckedReceipt(receipt)
+		gen.AddUncheckedTx(types.NewTransaction(uint64(i), common.HexToAddress("0x1"), big.NewInt(1), 1, gen.BaseFee(), nil))
+	})
+	gspec.MustCommit(db)
+	for i, block := range chain {
+		rawdb.WriteBlock(db, block)
+		rawdb.WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+		rawdb.WriteHeadBlockHash(db, block.Hash())
+		rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), receipts[i])
+
+		for _, log := range receipts[i][0].Logs {
+			for _, item := range [][]byte{log.Address.Bytes(), log.Topics[0].Bytes()} {
+				blocks := backend.index[string(item)]
+				if len(blocks) == 0 || blocks[len(blocks)-1] != block.NumberU64() {
+					backend.index[string(item)] = append(blocks, block.NumberU64())
+				}
+			}
+		}
+	}
+	// Queries using the index return the same logs as without it
+	tests := []struct {
+		begin, end int64
+		addresses  []common.Address
+		topics     [][]common.Hash
+	}{
+		{0, 19, addrs[:1], nil},
+		{0, 19, addrs[1:], nil},
+		{3, 12, nil, [][]common.Hash{topics[:1]}},
+		{9, 17, addrs[2:], [][]common.Hash{topics[1:]}},
+		{0, 19, addrs[:2], [][]common.Hash{{topics[2]}, {topics[0]}}},
+		{10, 14, nil, [][]common.Hash{nil, {topics[1]}}},
+		{12, 10, addrs, nil},
+	}
+	for i, tt := range tests {
+		want, err := NewFilterSystem(plain, Config{}).NewRangeFilter(tt.begin, tt.end, tt.addresses, tt.topics).Logs(context.Background())
+		if err != nil {
+			t.Fatalf("test %d: %v", i, err)
+		}
+		have, err := sys.NewRangeFilter(tt.begin, tt.end, tt.addresses, tt.topics).Logs(context.Background())
+		if err != nil {
+			t.Fatalf("test %d: %v", i, err)
+		}
+		if len(have) != len(want) {
+			t.Fatalf("test %d: wrong number of logs: have %d, want %d", i, len(have), len(want))
+		}
+		for j := range have {
+			if have[j].BlockNumber != want[j].BlockNumber || have[j].Index != want[j].Index {
+				t.Errorf("test %d: log %d: have block %d index %d, want block %d index %d", i, j, have[j].BlockNumber, have[j].Index, want[j].BlockNumber, want[j].Index)
+			}
+		}
+	}
+	// The blocks of the indexed sections are only looked up in the index
+	backend.index[string(addrs[0].Bytes())] = nil
+	logs, err := sys.NewRangeFilter(0, 19, addrs[:1], nil).Logs(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var before, after bool
+	for _, log := range logs {
+		switch {
+		case log.BlockNumber < 8:
+			before = true
+		case log.BlockNumber >= 16:
+			after = true
+		default:
+			t.Errorf("log of block %d not looked up in the index", log.BlockNumber)
+		}
+	}
+	if !before || !after {
+		t.Errorf("missing logs outside the index: before %v, after %v", before, after)
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package filters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	defaultPageBlocks  = 1000 // Blocks scanned per page if the block range is unlimited
+	defaultPageResults = 1000 // Logs returned per page if the results are unlimited
+)
+
+var (
+	errInvalidCursor = errors.New("invalid cursor")
+	errCursorQuery   = errors.New("cursor issued for a different query")
+	errReorged       = errors.New("chain reorganised, restart the query")
+)
+
+// LogsPage is a page of the logs matching a query, along with the cursor of the
+// next page.
+type LogsPage struct {
+	Logs   []*types.Log  `json:"logs"`
+	Cursor hexutil.Bytes `json:"cursor,omitempty"` // Missing once all logs were returned
+}
+
+// logCursor is the decoded continuation token of a paginated log query. It is
+// anchored to the hash of the block the next page starts at, which changes if
+// any block covered by the previous pages is reorganised.
+type logCursor struct {
+	Query common.Hash // Hash of the addresses and topics of the query
+	Next  uint64      // First block of the next page
+	Hash  common.Hash // Hash of the first block of the next page
+	Skip  uint64      // Matching logs of the first block already returned
+	End   uint64      // Last block of the query
+}
+
+// queryHash returns the hash identifying the addresses and topics of a query.
+func queryHash(crit FilterCriteria) common.Hash {
+	enc, _ := rlp.EncodeToBytes([]interface{}{crit.Addresses, crit.Topics})
+	return crypto.Keccak256Hash(enc)
+}
+
+// GetLogsPage returns the logs matching the given criteria one page at a time.
+// The first page is requested without a cursor, the next ones with the cursor
+// returned along with the previous page. The block range is resolved on the
+// first page, later pages fail if any block already covered gets reorganised.
+func (api *FilterAPI) GetLogsPage(ctx context.Context, crit FilterCriteria, cursor *hexutil.Bytes) (*LogsPage, error) {
+	if crit.BlockHash != nil {
+		return nil, errors.New("block hash queries can't be paginated")
+	}
+	var (
+		cur *logCursor
+		err error
+	)
+	if cursor == nil {
+		if cur, err = api.firstCursor(ctx, crit); cur == nil || err != nil {
+			return &LogsPage{Logs: []*types.Log{}}, err
+		}
+	} else {
+		cur = new(logCursor)
+		if err := rlp.DecodeBytes(*cursor, cur); err != nil {
+			return nil, errInvalidCursor
+		}
+		if cur.Query != queryHash(crit) {
+			return nil, errCursorQuery
+		}
+		header, err := api.sys.backend.HeaderByNumber(ctx, rpc.BlockNumber(cur.Next))
+		if err != nil {
+			return nil, err
+		}
+		if header == nil || header.Hash() != cur.Hash {
+			return nil, errReorged
+		}
+	}
+	return api.logsPage(ctx, crit, cur)
+}
+
+// firstCursor resolves the block range of a paginated query, returning nil if
+// it is empty.
+func (api *FilterAPI) firstCursor(ctx context.Context, crit FilterCriteria) (*logCursor, error) {
+	resolve := func(number *big.Int) (*types.Header, error) {
+		block := rpc.LatestBlockNumber
+		if number != nil {
+			block = rpc.BlockNumber(number.Int64())
+		}
+		if block == rpc.PendingBlockNumber {
+			return nil, errors.New("pending logs can't be paginated")
+		}
+		header, err := api.sys.backend.HeaderByNumber(ctx, block)
+		if header == nil && err == nil {
+			err = fmt.Errorf("block %v not found", block)
+		}
+		return header, err
+	}
+	head, err := resolve(nil)
+	if err != nil {
+		return nil, err
+	}
+	begin, end := head, head
+	if crit.FromBlock != nil && crit.FromBlock.Int64() < 0 {
+		if begin, err = resolve(crit.FromBlock); err != nil {
+			return nil, err
+		}
+	}
+	if crit.ToBlock != nil && crit.ToBlock.Int64() < 0 {
+		if end, err = resolve(crit.ToBlock); err != nil {
+			return nil, err
+		}
+	}
+	cur := &logCursor{
+		Query: queryHash(crit),
+		Next:  begin.Number.Uint64(),
+		End:   end.Number.Uint64(),
+	}
+	if crit.FromBlock != nil && crit.FromBlock.Sign() >= 0 {
+		cur.Next = crit.FromBlock.Uint64()
+	}
+	if crit.ToBlock != nil && crit.ToBlock.Sign() >= 0 {
+		cur.End = crit.ToBlock.Uint64()
+	}
+	if headNumber := head.Number.Uint64(); cur.End > headNumber {
+		cur.End = headNumber
+	}
+	if cur.Next > cur.End {
+		return nil, nil
+	}
+	return cur, nil
+}
+
+// logsPage collects the next page of logs of the query, starting at the cursor.
+func (api *FilterAPI) logsPage(ctx context.Context, crit FilterCriteria, cur *logCursor) (*LogsPage, error) {
+	maxBlocks, maxResults := api.sys.cfg.BlockRange, api.sys.cfg.MaxResults
+	if maxBlocks == 0 {
+		maxBlocks = defaultPageBlocks
+	}
+	if maxResults == 0 {
+		maxResults = defaultPageResults
+	}
+	to := cur.End
+	if cur.End-cur.Next >= maxBlocks {
+		to = cur.Next + maxBlocks - 1
+	}
+	// The block following the page is retrieved before the logs, a reorg of any
+	// block of the page changes its hash.
+	check := to
+	if to < cur.End {
+		check = to + 1
+	}
+	next, err := api.sys.backend.HeaderByNumber(ctx, rpc.BlockNumber(check))
+	if err != nil {
+		return nil, err
+	}
+	if next == nil {
+		return nil, errReorged
+	}
+	// The page is truncated instead of failing on too many logs. The search ends
+	// once a log beyond the page is found, always including whole blocks.
+	filter := api.sys.NewRangeFilter(int64(cur.Next), int64(to), crit.Addresses, crit.Topics)
+	filter.maxResults = 0
+	filter.stopAfter = int(cur.Skip) + maxResults + 1
+
+	logs, err := filter.Logs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if header, err := api.sys.backend.HeaderByNumber(ctx, rpc.BlockNumber(check)); err != nil {
+		return nil, err
+	} else if header == nil || header.Hash() != next.Hash() {
+		return nil, errReorged
+	}
+	// Drop the logs of the first block returned by the previous page
+	if skip := int(cur.Skip); skip > 0 {
+		if skip > len(logs) {
+			skip = len(logs)
+		}
+		logs = logs[skip:]
+	}
+	page := &LogsPage{Logs: returnLogs(logs)}
+	switch {
+	case len(logs) > maxResults:
+		// The page ends within the block of the first log left out
+		first := logs[maxResults]
+		skip := uint64(0)
+		if first.BlockNumber == cur.Next {
+			skip = cur.Skip
+		}
+		for _, log := range logs[:maxResults] {
+			if log.BlockNumber == first.BlockNumber {
+				skip++
+			}
+		}
+		page.Logs = logs[:maxResults]
+		cur.Next, cur.Hash, cur.Skip = first.BlockNumber, first.BlockHash, skip
+
+	case to < cur.End:
+		cur.Next, cur.Hash, cur.Skip = to+1, next.Hash(), 0
+
+	default:
+		return page, nil
+	}
+	if page.Cursor, err = rlp.EncodeToBytes(cur); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
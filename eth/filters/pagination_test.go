@@ -0,0 +1,152 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package filters
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func TestLogQueryLimitsAndPagination(t *testing.T) {
+	var (
+		db       = rawdb.NewMemoryDatabase()
+		_, sys   = newTestFilterSystem(t, db, Config{BlockRange: 10, MaxResults: 5})
+		api      = NewFilterAPI(sys, false)
+		addr     = common.BytesToAddress([]byte("logger"))
+		config   = *params.TestChainConfig
+		topics   []common.Hash // topics of all logs, in order
+		ctx      = context.Background()
+		gspec    = &core.Genesis{Config: &config, BaseFee: big.NewInt(params.InitialBaseFee)}
+		topicOf  = func(block, index int) common.Hash { return common.BigToHash(big.NewInt(int64(block*10 + index))) }
+		topicsOf = func(logs []*types.Log) (have []common.Hash) {
+			for _, log := range logs {
+				have = append(have, log.Topics[0])
+			}
+			return have
+		}
+	)
+	config.CepheusBlock = big.NewInt(0)
+
+	// Every third block starting at 1 contains two logs with distinct topics
+	_, chain, receipts := core.GenerateChainWithGenesis(gspec, ethash.NewFaker(), 20, func(i int, gen *core.BlockGen) {
+		if i%3 != 0 {
+			return
+		}
+		receipt := types.NewReceipt(nil, false, 0)
+		for j := 0; j < 2; j++ {
+			topic := topicOf(i+1, j)
+			receipt.Logs = append(receipt.Logs, &types.Log{Address: addr, Topics: []common.Hash{topic}})
+			topics = append(topics, topic)
+		}
+		receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+		gen.AddUncheckedReceipt(receipt)
+		gen.AddUncheckedTx(types.NewTransaction(uint64(i), common.HexToAddress("0x1"), big.NewInt(1), 1, gen.BaseFee(), nil))
+	})
+	gspec.MustCommit(db)
+	for i, block := range chain {
+		rawdb.WriteBlock(db, block)
+		rawdb.WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+		rawdb.WriteHeadBlockHash(db, block.Hash())
+		rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), receipts[i])
+	}
+
+	// Plain queries fail beyond the block range and the result caps
+	if _, err := sys.NewRangeFilter(0, 19, nil, nil).Logs(ctx); !errors.Is(err, errBlockRangeTooLarge) {
+		t.Fatalf("wrong error for large range: %v", err)
+	}
+	if _, err := sys.NewRangeFilter(0, 9, nil, nil).Logs(ctx); !errors.Is(err, errTooManyLogs) {
+		t.Fatalf("wrong error for too many logs: %v", err)
+	}
+	if logs, err := sys.NewRangeFilter(0, 5, nil, nil).Logs(ctx); err != nil || len(logs) != 4 {
+		t.Fatalf("wrong logs within limits: %d, %v", len(logs), err)
+	}
+
+	// Page searches end with the block completing the requested number of logs
+	filter := sys.NewRangeFilter(0, 9, nil, nil)
+	filter.maxResults, filter.stopAfter = 0, 3
+	if logs, err := filter.Logs(ctx); err != nil || len(logs) != 4 {
+		t.Fatalf("wrong logs of stopped search: %d, %v", len(logs), err)
+	}
+	if filter.begin != 5 {
+		t.Fatalf("search didn't stop after block 4: next block %d", filter.begin)
+	}
+
+	// Pages return all logs in order, split within blocks if necessary
+	var (
+		crit   = FilterCriteria{FromBlock: big.NewInt(0), Addresses: []common.Address{addr}}
+		cursor *hexutil.Bytes
+		have   []common.Hash
+		pages  int
+	)
+	for {
+		page, err := api.GetLogsPage(ctx, crit, cursor)
+		if err != nil {
+			t.Fatalf("page %d: %v", pages, err)
+		}
+		pages++
+		if len(page.Logs) > 5 {
+			t.Fatalf("page %d: too many logs: %d", pages, len(page.Logs))
+		}
+		have = append(have, topicsOf(page.Logs)...)
+		if page.Cursor == nil {
+			break
+		}
+		cursor = &page.Cursor
+	}
+	if len(have) != len(topics) {
+		t.Fatalf("wrong number of logs: have %d, want %d", len(have), len(topics))
+	}
+	for i := range have {
+		if have[i] != topics[i] {
+			t.Fatalf("log %d: have topic %x, want %x", i, have[i], topics[i])
+		}
+	}
+	if pages < 3 { // 14 logs, at most 5 per page
+		t.Fatalf("too few pages: %d", pages)
+	}
+
+	// Cursors are bound to the query and the chain they were issued on
+	page, err := api.GetLogsPage(ctx, FilterCriteria{FromBlock: big.NewInt(0), ToBlock: big.NewInt(12)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Logs) != 5 || page.Cursor == nil {
+		t.Fatalf("wrong first page: %d logs, cursor %v", len(page.Logs), page.Cursor)
+	}
+	if _, err := api.GetLogsPage(ctx, crit, &page.Cursor); !errors.Is(err, errCursorQuery) {
+		t.Fatalf("wrong error for different query: %v", err)
+	}
+	var cur logCursor
+	if err := rlp.DecodeBytes(page.Cursor, &cur); err != nil {
+		t.Fatal(err)
+	}
+	rawdb.WriteCanonicalHash(db, common.Hash{1}, cur.Next)
+	if _, err := api.GetLogsPage(ctx, FilterCriteria{FromBlock: big.NewInt(0), ToBlock: big.NewInt(12)}, &page.Cursor); !errors.Is(err, errReorged) {
+		t.Fatalf("wrong error after reorg: %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package filters
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// TxEventCriteria selects the transaction pool events to notify about. Empty
+// fields match all the events.
+type TxEventCriteria struct {
+	Addresses []common.Address       `json:"addresses"` // Senders of the transactions
+	Reasons   []txpool.TxEventReason `json:"reasons"`
+}
+
+// matches returns whether the event is selected by the criteria.
+func (crit *TxEventCriteria) matches(ev *txpool.TxEvent) bool {
+	if crit == nil {
+		return true
+	}
+	if len(crit.Addresses) > 0 && !includes(crit.Addresses, ev.From) {
+		return false
+	}
+	if len(crit.Reasons) > 0 {
+		for _, reason := range crit.Reasons {
+			if reason == ev.Reason {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// TxPoolEventsAPI offers subscriptions to the transaction pool state transitions.
+type TxPoolEventsAPI struct {
+	events *EventSystem
+}
+
+// NewTxPoolEventsAPI returns a new TxPoolEventsAPI instance sharing the event
+// system of the given filter API.
+func NewTxPoolEventsAPI(api *FilterAPI) *TxPoolEventsAPI {
+	return &TxPoolEventsAPI{events: api.events}
+}
+
+// Events creates a subscription that fires each time a transaction matching the
+// given criteria leaves the transaction pool or gets demoted, along with the
+// reason of the transition.
+func (api *TxPoolEventsAPI) Events(ctx context.Context, crit *TxEventCriteria) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		events := make(chan []*txpool.TxEvent, 128)
+		eventsSub := api.events.SubscribeTxPoolEvents(events)
+
+		for {
+			select {
+			case evs := <-events:
+				for _, ev := range evs {
+					if crit.matches(ev) {
+						notifier.Notify(rpcSub.ID, ev)
+					}
+				}
+			case <-rpcSub.Err():
+				eventsSub.Unsubscribe()
+				return
+			case <-notifier.Closed():
+				eventsSub.Unsubscribe()
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
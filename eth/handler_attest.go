@@ -0,0 +1,55 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/eth/protocols/attest"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+// attestHandler implements the attest.Backend interface to handle the
+// attestations broadcast by the remote peers.
+type attestHandler handler
+
+func (h *attestHandler) Chain() *core.BlockChain { return h.chain }
+
+// RunPeer is invoked when a peer joins on the `attest` protocol.
+func (h *attestHandler) RunPeer(peer *attest.Peer, hand attest.Handler) error {
+	if err := h.attestations.RegisterPeer(peer); err != nil {
+		return err
+	}
+	defer h.attestations.UnregisterPeer(peer)
+
+	return hand(peer)
+}
+
+// PeerInfo retrieves all known `attest` information about a peer.
+func (h *attestHandler) PeerInfo(id enode.ID) interface{} {
+	return nil
+}
+
+// Handle is invoked from a peer's message handler when it receives a new remote
+// message that the handler couldn't consume and serve itself.
+func (h *attestHandler) Handle(peer *attest.Peer, packet attest.Packet) error {
+	switch packet := packet.(type) {
+	case *attest.AttestationsPacket:
+		return h.attestations.Add(*packet)
+	default:
+		return nil
+	}
+}
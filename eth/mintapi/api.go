@@ -0,0 +1,190 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package mintapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/mint"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var errMintContractNotDeployed = errors.New("mint contract is not deployed at requested block")
+
+// Backend provides access to chain state that is required by the mint API.
+type Backend interface {
+	StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error)
+	ChainDb() ethdb.Database
+}
+
+// API exposes mint contract state and history over the "mint" RPC namespace.
+type API struct {
+	backend Backend
+	sys     *filters.FilterSystem
+}
+
+// NewAPI creates a new mint API instance.
+func NewAPI(backend Backend, sys *filters.FilterSystem) *API {
+	return &API{backend: backend, sys: sys}
+}
+
+// APIs returns the RPC descriptors the mint API offers.
+func APIs(backend Backend, sys *filters.FilterSystem) []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "mint",
+			Service:   NewAPI(backend, sys),
+		},
+	}
+}
+
+// State is the RPC representation of mint contract state at a specific block.
+type State struct {
+	Owner       common.Address `json:"owner"`
+	Limit       *hexutil.Big   `json:"limit"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+}
+
+// GetState returns mint contract owner and remaining mint limit at the specified block.
+// If block is not specified, the latest block is used.
+func (api *API) GetState(ctx context.Context, blockNrOrHash *rpc.BlockNumberOrHash) (*State, error) {
+	if blockNrOrHash == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &latest
+	}
+
+	stateDB, header, err := api.backend.StateAndHeaderByNumberOrHash(ctx, *blockNrOrHash)
+	if stateDB == nil || err != nil {
+		return nil, err
+	}
+
+	if !mint.IsDeployed(stateDB) {
+		return nil, errMintContractNotDeployed
+	}
+
+	mintState := mint.ReadState(stateDB)
+
+	return &State{
+		Owner:       mintState.Owner,
+		Limit:       (*hexutil.Big)(mintState.Limit),
+		BlockNumber: hexutil.Uint64(header.Number.Uint64()),
+		BlockHash:   header.Hash(),
+	}, nil
+}
+
+// Mint is the RPC representation of a single Mint event.
+type Mint struct {
+	Amount        *hexutil.Big   `json:"amount"`
+	BurnTxHash    common.Hash    `json:"burnTxHash"`
+	BurnTxNetwork hexutil.Uint   `json:"burnTxNetwork"`
+	BlockNumber   hexutil.Uint64 `json:"blockNumber"`
+	BlockHash     common.Hash    `json:"blockHash"`
+	TxHash        common.Hash    `json:"transactionHash"`
+	TxIndex       hexutil.Uint   `json:"transactionIndex"`
+	LogIndex      hexutil.Uint   `json:"logIndex"`
+}
+
+// GetMints returns decoded Mint events emitted within the specified block range (inclusive).
+// Missing range boundaries default to the latest block.
+func (api *API) GetMints(ctx context.Context, fromBlock, toBlock *rpc.BlockNumber) ([]*Mint, error) {
+	begin := rpc.LatestBlockNumber.Int64()
+	if fromBlock != nil {
+		begin = fromBlock.Int64()
+	}
+	end := rpc.LatestBlockNumber.Int64()
+	if toBlock != nil {
+		end = toBlock.Int64()
+	}
+
+	filter := api.sys.NewRangeFilter(begin, end, []common.Address{mint.Contract.Address}, [][]common.Hash{{mint.EventID()}})
+	logs, err := filter.Logs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeMints(logs), nil
+}
+
+func decodeMints(logs []*types.Log) []*Mint {
+	mints := make([]*Mint, 0, len(logs))
+	for _, l := range logs {
+		event, err := mint.UnpackEvent(l)
+		if err != nil {
+			log.Warn("Failed to decode Mint event", "block", l.BlockNumber, "tx", l.TxHash, "error", err)
+			continue
+		}
+
+		mints = append(mints, &Mint{
+			Amount:        (*hexutil.Big)(event.Amount),
+			BurnTxHash:    event.BurnTxHash,
+			BurnTxNetwork: hexutil.Uint(event.BurnTxNetwork),
+			BlockNumber:   hexutil.Uint64(l.BlockNumber),
+			BlockHash:     l.BlockHash,
+			TxHash:        l.TxHash,
+			TxIndex:       hexutil.Uint(l.TxIndex),
+			LogIndex:      hexutil.Uint(l.Index),
+		})
+	}
+
+	return mints
+}
+
+// MintLocation is the RPC representation of a mint transaction location.
+type MintLocation struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	TxHash      common.Hash    `json:"transactionHash"`
+	TxIndex     hexutil.Uint   `json:"transactionIndex"`
+}
+
+// GetByBurnTx returns canonical mint transactions referring to the specified burn tx.
+// An empty list is returned if the burn tx has not been minted yet.
+// More than one location means that the same burn tx has been minted several times.
+func (api *API) GetByBurnTx(burnTxNetwork hexutil.Uint, burnTxHash common.Hash) ([]*MintLocation, error) {
+	if burnTxNetwork > 0xff {
+		return nil, errors.New("invalid burn tx network")
+	}
+
+	db := api.backend.ChainDb()
+	entries := rawdb.ReadMintLookupEntries(db, byte(burnTxNetwork), burnTxHash)
+
+	locations := make([]*MintLocation, 0, len(entries))
+	for _, entry := range entries {
+		// Index may contain stale entries after the chain has been rewound
+		if rawdb.ReadCanonicalHash(db, entry.BlockNumber) != entry.BlockHash {
+			continue
+		}
+		locations = append(locations, &MintLocation{
+			BlockNumber: hexutil.Uint64(entry.BlockNumber),
+			BlockHash:   entry.BlockHash,
+			TxHash:      entry.TxHash,
+			TxIndex:     hexutil.Uint(entry.TxIndex),
+		})
+	}
+
+	return locations, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package mintapi
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/mint"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+type testBackend struct {
+	db      ethdb.Database
+	stateDB *state.StateDB
+	header  *types.Header
+}
+
+func (b *testBackend) ChainDb() ethdb.Database {
+	return b.db
+}
+
+func (b *testBackend) StateAndHeaderByNumberOrHash(context.Context, rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error) {
+	return b.stateDB, b.header, nil
+}
+
+func TestGetState(t *testing.T) {
+	owner := common.HexToAddress("0x1000000000000000000000000000000000000000")
+	limit := big.NewInt(1000000000000000000)
+
+	stateDB, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	backend := &testBackend{stateDB: stateDB, header: &types.Header{Number: big.NewInt(10)}}
+	api := NewAPI(backend, nil)
+
+	_, err := api.GetState(context.Background(), nil)
+	assert.ErrorIs(t, err, errMintContractNotDeployed)
+
+	stateDB.SetCode(mint.Contract.Address, mint.Contract.Bytecode)
+	stateDB.SetState(mint.Contract.Address, mint.Contract.StorageLayout.Owner, owner.Hash())
+	stateDB.SetState(mint.Contract.Address, mint.Contract.StorageLayout.MintLimit, common.BigToHash(limit))
+
+	result, err := api.GetState(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, owner, result.Owner)
+	assert.Equal(t, limit, result.Limit.ToInt())
+	assert.Equal(t, uint64(10), uint64(result.BlockNumber))
+	assert.Equal(t, backend.header.Hash(), result.BlockHash)
+}
+
+func TestDecodeMints(t *testing.T) {
+	data, _ := mint.PackEvent(big.NewInt(5), common.HexToHash("0xabcd"), mint.BurnNetworkEthereum)
+
+	mints := decodeMints([]*types.Log{
+		{
+			Address:     mint.Contract.Address,
+			Topics:      []common.Hash{mint.EventID()},
+			Data:        data,
+			BlockNumber: 7,
+			TxHash:      common.HexToHash("0x01"),
+			TxIndex:     1,
+			Index:       2,
+		},
+		{
+			Address: mint.Contract.Address,
+			Topics:  []common.Hash{mint.EventID()},
+			Data:    []byte{1},
+		},
+	})
+
+	assert.Len(t, mints, 1)
+	assert.Equal(t, big.NewInt(5), mints[0].Amount.ToInt())
+	assert.Equal(t, common.HexToHash("0xabcd"), mints[0].BurnTxHash)
+	assert.Equal(t, uint(mint.BurnNetworkEthereum), uint(mints[0].BurnTxNetwork))
+	assert.Equal(t, uint64(7), uint64(mints[0].BlockNumber))
+	assert.Equal(t, common.HexToHash("0x01"), mints[0].TxHash)
+	assert.Equal(t, uint(2), uint(mints[0].LogIndex))
+}
+
+func TestGetByBurnTx(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	api := NewAPI(&testBackend{db: db}, nil)
+
+	burnTxHash := common.HexToHash("0xabcd")
+	canonical := rawdb.MintLookupEntry{BlockHash: common.HexToHash("0x01"), BlockNumber: 1, TxHash: common.HexToHash("0x11")}
+	stale := rawdb.MintLookupEntry{BlockHash: common.HexToHash("0x02"), BlockNumber: 2, TxHash: common.HexToHash("0x12"), TxIndex: 3}
+
+	rawdb.WriteCanonicalHash(db, canonical.BlockHash, canonical.BlockNumber)
+	rawdb.WriteMintLookupEntries(db, mint.BurnNetworkTron, burnTxHash, []rawdb.MintLookupEntry{canonical, stale})
+
+	locations, err := api.GetByBurnTx(hexutil.Uint(mint.BurnNetworkEthereum), burnTxHash)
+	assert.NoError(t, err)
+	assert.Len(t, locations, 0)
+
+	locations, err = api.GetByBurnTx(hexutil.Uint(mint.BurnNetworkTron), burnTxHash)
+	assert.NoError(t, err)
+	assert.Len(t, locations, 1)
+	assert.Equal(t, canonical.TxHash, locations[0].TxHash)
+
+	rawdb.WriteCanonicalHash(db, stale.BlockHash, stale.BlockNumber)
+
+	locations, err = api.GetByBurnTx(hexutil.Uint(mint.BurnNetworkTron), burnTxHash)
+	assert.NoError(t, err)
+	assert.Len(t, locations, 2)
+	assert.Equal(t, uint(3), uint(locations[1].TxIndex))
+
+	_, err = api.GetByBurnTx(256, burnTxHash)
+	assert.Error(t, err)
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package attest
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/consensus/clique"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p"
+)
+
+const (
+	// maxKnownAttestations is the maximum attestation identifiers to keep in the
+	// known list (prevent DOS).
+	maxKnownAttestations = 4096
+
+	// maxQueuedAttestations is the maximum number of attestation batches to queue
+	// up before dropping broadcasts.
+	maxQueuedAttestations = 16
+)
+
+// Peer is a collection of relevant information we have about an `attest` peer.
+type Peer struct {
+	id string // Unique ID for the peer, cached
+
+	*p2p.Peer                   // The embedded P2P package peer
+	rw        p2p.MsgReadWriter // Input/output streams for attest
+	version   uint              // Protocol version negotiated
+
+	known *lru.Cache[common.Hash, struct{}] // Set of attestation identifiers known to be known by this peer
+	queue chan []*clique.Attestation        // Queue of attestations to broadcast to the peer
+	term  chan struct{}                     // Termination channel to stop the broadcaster
+
+	logger log.Logger // Contextual logger with the peer id injected
+}
+
+// NewPeer create a wrapper for a network connection and negotiated  protocol
+// version.
+func NewPeer(version uint, p *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
+	id := p.ID().String()
+	peer := &Peer{
+		id:      id,
+		Peer:    p,
+		rw:      rw,
+		version: version,
+		known:   lru.NewCache[common.Hash, struct{}](maxKnownAttestations),
+		queue:   make(chan []*clique.Attestation, maxQueuedAttestations),
+		term:    make(chan struct{}),
+		logger:  log.New("peer", id[:8]),
+	}
+	go peer.broadcastAttestations()
+	return peer
+}
+
+// Close signals the broadcast goroutine to terminate. Only ever call this if
+// you created the peer yourself via NewPeer.
+func (p *Peer) Close() {
+	close(p.term)
+}
+
+// ID retrieves the peer's unique identifier.
+func (p *Peer) ID() string {
+	return p.id
+}
+
+// Version retrieves the peer's negotiated `attest` protocol version.
+func (p *Peer) Version() uint {
+	return p.version
+}
+
+// Log overrides the P2P logger with the higher level one containing only the id.
+func (p *Peer) Log() log.Logger {
+	return p.logger
+}
+
+// KnownAttestation returns whether the peer is known to already have the attestation.
+func (p *Peer) KnownAttestation(id common.Hash) bool {
+	return p.known.Contains(id)
+}
+
+// markAttestation marks the attestation as known for the peer, ensuring that it
+// will never be propagated to this particular peer.
+func (p *Peer) markAttestation(id common.Hash) {
+	p.known.Add(id, struct{}{})
+}
+
+// AsyncSendAttestations queues a batch of attestations for propagation to the
+// remote peer. If the peer's broadcast queue is full, the batch is silently
+// dropped.
+func (p *Peer) AsyncSendAttestations(attestations []*clique.Attestation) {
+	select {
+	case p.queue <- attestations:
+		for _, attestation := range attestations {
+			p.markAttestation(attestation.ID())
+		}
+	default:
+		p.Log().Debug("Dropping attestation propagation", "count", len(attestations))
+	}
+}
+
+// broadcastAttestations is a write loop that sends the queued attestations to
+// the remote peer. The goal is to have an async writer that does not lock up
+// node internals and at the same time rate limits queued data.
+func (p *Peer) broadcastAttestations() {
+	for {
+		select {
+		case attestations := <-p.queue:
+			if err := p2p.Send(p.rw, AttestationsMsg, AttestationsPacket(attestations)); err != nil {
+				return
+			}
+			p.Log().Trace("Propagated attestations", "count", len(attestations))
+
+		case <-p.term:
+			return
+		}
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package attest
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/clique"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// maxAttestationDistance is the maximum number of blocks ahead of the local
+// head an attestation can be for to be tracked.
+const maxAttestationDistance = 1024
+
+var errAlreadyRegistered = errors.New("peer already registered")
+
+// droppedAttestationMeter counts the validly signed attestations refused by the
+// pool, e.g. by signers not authorized in the local view of the chain.
+var droppedAttestationMeter = metrics.NewRegisteredMeter("eth/protocols/attest/dropped", nil)
+
+// Pool collects attestations of clique signers, attests canonical blocks by the
+// local signer and finalizes blocks with a quorum certificate, i.e. attested
+// by more than half of the signers.
+type Pool struct {
+	db     ethdb.KeyValueStore // Database to persist the local attestations in
+	chain  *core.BlockChain
+	engine *clique.Clique
+
+	peers    map[string]*Peer                                       // Peers connected on the `attest` protocol
+	votes    map[common.Hash]map[common.Address]*clique.Attestation // Attestations of non-finalized blocks by block hash
+	numbers  map[common.Hash]uint64                                 // Numbers of attested non-finalized blocks
+	attested uint64                                                 // Number of the highest block attested by the local signer, persisted across restarts
+	lock     sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewPool creates an attestation pool on top of the chain sealed by the clique
+// engine. The local signer never attests a block at or below the height of the
+// last attestation stored in the database, which rules out equivocation after
+// a restart.
+func NewPool(db ethdb.KeyValueStore, chain *core.BlockChain, engine *clique.Clique) *Pool {
+	attested, _, _ := rawdb.ReadLastAttestation(db)
+	return &Pool{
+		db:       db,
+		chain:    chain,
+		engine:   engine,
+		peers:    make(map[string]*Peer),
+		votes:    make(map[common.Hash]map[common.Address]*clique.Attestation),
+		numbers:  make(map[common.Hash]uint64),
+		attested: attested,
+		quit:     make(chan struct{}),
+	}
+}
+
+// Start starts attesting new chain heads by the local signer.
+func (p *Pool) Start() {
+	p.wg.Add(1)
+	go p.loop()
+}
+
+// Stop terminates the attestation pool.
+func (p *Pool) Stop() {
+	close(p.quit)
+	p.wg.Wait()
+}
+
+// RegisterPeer injects a new `attest` peer into the pool.
+func (p *Pool) RegisterPeer(peer *Peer) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.peers[peer.id]; ok {
+		return errAlreadyRegistered
+	}
+	p.peers[peer.id] = peer
+	return nil
+}
+
+// UnregisterPeer removes a remote peer from the pool.
+func (p *Pool) UnregisterPeer(peer *Peer) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	delete(p.peers, peer.id)
+}
+
+// Add verifies and collects attestations received from the remote peers,
+// propagating the new ones to the peers not knowing them yet. Attestations of
+// unknown or already finalized blocks are ignored, the ones refused by the pool
+// are skipped. An error is returned only if the batch contains a malformed
+// signature, after the valid attestations have been processed.
+func (p *Pool) Add(attestations []*clique.Attestation) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var (
+		fresh   []*clique.Attestation
+		invalid error
+	)
+	for _, attestation := range attestations {
+		added, err := p.add(attestation)
+		switch {
+		case errors.Is(err, clique.ErrInvalidAttestation):
+			invalid = err
+			continue
+		case err != nil:
+			droppedAttestationMeter.Mark(1)
+			log.Trace("Dropped attestation", "number", attestation.Number, "hash", attestation.Hash, "err", err)
+			continue
+		}
+		if added {
+			fresh = append(fresh, attestation)
+		}
+	}
+	if len(fresh) > 0 {
+		p.broadcast(fresh)
+		p.finalize()
+	}
+	return invalid
+}
+
+// add verifies and collects a single attestation, returning whether it has not
+// been known yet. The caller must hold the lock.
+func (p *Pool) add(attestation *clique.Attestation) (bool, error) {
+	if final := p.chain.CurrentFinalBlock(); final != nil && attestation.Number <= final.Number.Uint64() {
+		return false, nil
+	}
+	if attestation.Number > p.chain.CurrentBlock().Number.Uint64()+maxAttestationDistance {
+		return false, nil
+	}
+	if p.chain.GetHeader(attestation.Hash, attestation.Number) == nil {
+		return false, nil
+	}
+	signer, err := p.engine.AttestationSigner(p.chain, attestation)
+	if err != nil {
+		return false, err
+	}
+	votes := p.votes[attestation.Hash]
+	if _, ok := votes[signer]; ok {
+		return false, nil
+	}
+	// Refuse attestations of conflicting blocks at the same height by the same
+	// signer, only the first one seen is counted and propagated
+	for hash, number := range p.numbers {
+		if number != attestation.Number || hash == attestation.Hash {
+			continue
+		}
+		if _, ok := p.votes[hash][signer]; ok {
+			log.Warn("Refused equivocating attestation", "signer", signer, "number", number, "attested", hash, "conflicting", attestation.Hash)
+			return false, nil
+		}
+	}
+	if votes == nil {
+		votes = make(map[common.Address]*clique.Attestation)
+		p.votes[attestation.Hash] = votes
+		p.numbers[attestation.Hash] = attestation.Number
+	}
+	votes[signer] = attestation
+	return true, nil
+}
+
+// broadcast propagates the attestations to all peers not knowing them yet. The
+// caller must hold the lock.
+func (p *Pool) broadcast(attestations []*clique.Attestation) {
+	for _, peer := range p.peers {
+		var unknown []*clique.Attestation
+		for _, attestation := range attestations {
+			if !peer.KnownAttestation(attestation.ID()) {
+				unknown = append(unknown, attestation)
+			}
+		}
+		if len(unknown) > 0 {
+			peer.AsyncSendAttestations(unknown)
+		}
+	}
+}
+
+// finalize marks the highest canonical block with a quorum certificate as
+// finalized and drops the attestations no longer needed. The caller must hold
+// the lock.
+func (p *Pool) finalize() {
+	var (
+		final   = p.chain.CurrentFinalBlock()
+		current uint64
+	)
+	if final != nil {
+		current = final.Number.Uint64()
+	}
+	for hash, number := range p.numbers {
+		if number <= current || p.chain.GetCanonicalHash(number) != hash {
+			continue
+		}
+		quorum, err := p.engine.AttestationQuorum(p.chain, number, hash)
+		if err != nil || len(p.votes[hash]) < quorum {
+			continue
+		}
+		if header := p.chain.GetHeader(hash, number); header != nil {
+			final, current = header, number
+		}
+	}
+	if final == nil || (p.chain.CurrentFinalBlock() != nil && p.chain.CurrentFinalBlock().Hash() == final.Hash()) {
+		return
+	}
+	log.Info("Finalized block with quorum certificate", "number", current, "hash", final.Hash(), "attestations", len(p.votes[final.Hash()]))
+	p.chain.SetFinalized(final)
+
+	for hash, number := range p.numbers {
+		if number <= current {
+			delete(p.votes, hash)
+			delete(p.numbers, hash)
+		}
+	}
+}
+
+// attest signs an attestation of the highest block sealed on top by more than
+// half of the signers by the local signer, if it is authorized.
+func (p *Pool) attest() {
+	signer, ok := p.engine.LocalSigner()
+	if !ok {
+		return
+	}
+	target, err := p.engine.AttestationTarget(p.chain, p.chain.CurrentBlock())
+	if err != nil || target == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	number := target.Number.Uint64()
+	if number <= p.attested {
+		return
+	}
+	attestation, err := p.engine.Attest(number, target.Hash())
+	if err != nil {
+		log.Warn("Failed to attest block", "number", number, "err", err)
+		return
+	}
+	added, err := p.add(attestation)
+	if err != nil {
+		// The local signer is not authorized to attest
+		log.Trace("Skipping block attestation", "number", number, "signer", signer, "err", err)
+		return
+	}
+	// Persist the attestation before it leaves the node
+	p.attested = number
+	rawdb.WriteLastAttestation(p.db, number, target.Hash())
+	if added {
+		p.broadcast([]*clique.Attestation{attestation})
+		p.finalize()
+	}
+}
+
+// loop attests the new chain heads and finalizes them once the attestations
+// of other signers have been received.
+func (p *Pool) loop() {
+	defer p.wg.Done()
+
+	heads := make(chan core.ChainHeadEvent, 16)
+	sub := p.chain.SubscribeChainHeadEvent(heads)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-heads:
+			p.attest()
+
+			// Blocks might have become canonical after collecting their attestations
+			p.lock.Lock()
+			p.finalize()
+			p.lock.Unlock()
+
+		case <-sub.Err():
+			return
+		case <-p.quit:
+			return
+		}
+	}
+}
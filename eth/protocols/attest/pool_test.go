@@ -0,0 +1,212 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package attest
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/clique"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSigner is a clique signer key along with its address.
+type testSigner struct {
+	key  *ecdsa.PrivateKey
+	addr common.Address
+}
+
+func (s *testSigner) signFn(account accounts.Account, mimeType string, data []byte) ([]byte, error) {
+	return crypto.Sign(crypto.Keccak256(data), s.key)
+}
+
+// newTestChain creates a clique chain of the given length sealed in-turn by
+// the given number of signers, sorted in ascending order of their addresses.
+func newTestChain(t *testing.T, signers int, blocks int) (*core.BlockChain, []*testSigner) {
+	keys := make([]*testSigner, signers)
+	for i := range keys {
+		key, _ := crypto.GenerateKey()
+		keys[i] = &testSigner{key: key, addr: crypto.PubkeyToAddress(key.PublicKey)}
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i].addr[:], keys[j].addr[:]) < 0 })
+
+	config := *params.TestChainConfig
+	config.CepheusBlock = big.NewInt(0)
+	config.Clique = &params.CliqueConfig{Period: 1, Epoch: 30000}
+
+	genesis := &core.Genesis{
+		Config:    &config,
+		ExtraData: make([]byte, 32+common.AddressLength*signers+crypto.SignatureLength),
+		BaseFee:   big.NewInt(params.InitialBaseFee),
+	}
+	for i, signer := range keys {
+		copy(genesis.ExtraData[32+i*common.AddressLength:], signer.addr[:])
+	}
+	engine := clique.New(config.Clique, rawdb.NewMemoryDatabase())
+	_, chain, _ := core.GenerateChainWithGenesis(genesis, engine, blocks, func(i int, gen *core.BlockGen) {})
+	for i, block := range chain {
+		header := block.Header()
+		if i > 0 {
+			header.ParentHash = chain[i-1].Hash()
+		}
+		header.Extra = make([]byte, 32+crypto.SignatureLength)
+		header.Difficulty = big.NewInt(2)
+
+		sig, _ := crypto.Sign(clique.SealHash(header).Bytes(), keys[header.Number.Uint64()%uint64(signers)].key)
+		copy(header.Extra[32:], sig)
+		chain[i] = block.WithSeal(header)
+	}
+	bc, err := core.NewBlockChain(rawdb.NewMemoryDatabase(), nil, genesis, nil, engine, vm.Config{}, nil, nil)
+	require.NoError(t, err)
+	_, err = bc.InsertChain(chain)
+	require.NoError(t, err)
+	return bc, keys
+}
+
+// newTestEngine creates a clique engine authorized to sign by the given signer.
+func newTestEngine(chain *core.BlockChain, signer *testSigner) *clique.Clique {
+	engine := clique.New(chain.Config().Clique, rawdb.NewMemoryDatabase())
+	engine.Authorize(signer.addr, signer.signFn)
+	return engine
+}
+
+func TestPoolFinalization(t *testing.T) {
+	chain, signers := newTestChain(t, 3, 6)
+	defer chain.Stop()
+
+	pool := NewPool(rawdb.NewMemoryDatabase(), chain, newTestEngine(chain, signers[0]))
+
+	// Connect a remote peer to check the propagation
+	local, remote := p2p.MsgPipe()
+	defer local.Close()
+	peer := NewPeer(ATTEST1, p2p.NewPeer(enode.ID{1}, "remote", nil), local)
+	defer peer.Close()
+	require.NoError(t, pool.RegisterPeer(peer))
+
+	// The block sealed on top by 2 of 3 signers is attested by the local signer
+	pool.attest()
+	target := chain.GetHeaderByNumber(4)
+
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	var packet AttestationsPacket
+	require.NoError(t, msg.Decode(&packet))
+	require.Len(t, packet, 1)
+	assert.Equal(t, target.Hash(), packet[0].Hash)
+	assert.Nil(t, chain.CurrentFinalBlock())
+
+	// Attestations of unauthorized signers are skipped
+	outsider, _ := crypto.GenerateKey()
+	attestation, err := newTestEngine(chain, &testSigner{outsider, crypto.PubkeyToAddress(outsider.PublicKey)}).Attest(4, target.Hash())
+	require.NoError(t, err)
+	assert.NoError(t, pool.Add([]*clique.Attestation{attestation}))
+	assert.Len(t, pool.votes[target.Hash()], 1)
+	assert.Nil(t, chain.CurrentFinalBlock())
+
+	// The second attestation forms a quorum certificate, even if delivered along
+	// with a malformed one
+	attestation, err = newTestEngine(chain, signers[1]).Attest(4, target.Hash())
+	require.NoError(t, err)
+	malformed := &clique.Attestation{Number: 4, Hash: target.Hash(), Signature: []byte{1}}
+	assert.ErrorIs(t, pool.Add([]*clique.Attestation{malformed, attestation}), clique.ErrInvalidAttestation)
+	require.NotNil(t, chain.CurrentFinalBlock())
+	assert.Equal(t, target.Hash(), chain.CurrentFinalBlock().Hash())
+
+	msg, err = remote.ReadMsg()
+	require.NoError(t, err)
+	require.NoError(t, msg.Decode(&packet))
+	assert.Equal(t, []*clique.Attestation{attestation}, []*clique.Attestation(packet))
+
+	// Attestations of finalized blocks are ignored
+	attestation, err = newTestEngine(chain, signers[2]).Attest(4, target.Hash())
+	require.NoError(t, err)
+	require.NoError(t, pool.Add([]*clique.Attestation{attestation}))
+	assert.Empty(t, pool.votes)
+}
+
+func TestPoolEquivocation(t *testing.T) {
+	chain, signers := newTestChain(t, 3, 6)
+	defer chain.Stop()
+
+	// Import a sibling of the head sealed by the same signer
+	head := chain.GetBlockByNumber(6)
+	header := types.CopyHeader(head.Header())
+	header.Time++
+	sig, _ := crypto.Sign(clique.SealHash(header).Bytes(), signers[0].key)
+	copy(header.Extra[32:], sig)
+	sibling := types.NewBlockWithHeader(header)
+	_, err := chain.InsertChain(types.Blocks{sibling})
+	require.NoError(t, err)
+
+	pool := NewPool(rawdb.NewMemoryDatabase(), chain, newTestEngine(chain, signers[0]))
+	engine := newTestEngine(chain, signers[1])
+
+	// Only the first of the conflicting attestations by the same signer is counted
+	first, err := engine.Attest(6, head.Hash())
+	require.NoError(t, err)
+	require.NoError(t, pool.Add([]*clique.Attestation{first}))
+
+	conflicting, err := engine.Attest(6, sibling.Hash())
+	require.NoError(t, err)
+	require.NoError(t, pool.Add([]*clique.Attestation{conflicting}))
+
+	assert.Len(t, pool.votes[head.Hash()], 1)
+	assert.NotContains(t, pool.votes, sibling.Hash())
+
+	// Attestations of other signers on the sibling are still collected
+	other, err := newTestEngine(chain, signers[2]).Attest(6, sibling.Hash())
+	require.NoError(t, err)
+	require.NoError(t, pool.Add([]*clique.Attestation{other}))
+	assert.Len(t, pool.votes[sibling.Hash()], 1)
+}
+
+func TestPoolAttestationPersistence(t *testing.T) {
+	chain, signers := newTestChain(t, 3, 6)
+	defer chain.Stop()
+
+	db := rawdb.NewMemoryDatabase()
+	engine := newTestEngine(chain, signers[0])
+
+	// The local attestation is stored before being propagated
+	pool := NewPool(db, chain, engine)
+	pool.attest()
+
+	target := chain.GetHeaderByNumber(4)
+	number, hash, ok := rawdb.ReadLastAttestation(db)
+	require.True(t, ok)
+	assert.Equal(t, uint64(4), number)
+	assert.Equal(t, target.Hash(), hash)
+
+	// A restarted pool doesn't attest the same height again
+	restarted := NewPool(db, chain, engine)
+	restarted.attest()
+	assert.Empty(t, restarted.votes)
+}
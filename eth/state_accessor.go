@@ -205,6 +205,9 @@ func (eth *Ethereum) stateAtTransaction(ctx context.Context, block *types.Block,
 	if err != nil {
 		return nil, vm.BlockContext{}, nil, nil, err
 	}
+	// Apply state migrations scheduled for this block, as the state processor does it before any transaction.
+	state.InitMigrations(eth.blockchain.Config()).Execute(block.Number(), statedb, "state accessor")
+
 	if txIndex == 0 && len(block.Transactions()) == 0 {
 		return nil, vm.BlockContext{}, statedb, release, nil
 	}
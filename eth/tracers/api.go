@@ -66,6 +66,10 @@ const (
 	// for tracing. The creation of trace state will be paused if the unused
 	// trace states exceed this limit.
 	maximumPendingTraceStates = 128
+
+	// maxTraceCallManyCalls is the maximum number of calls traced by a single
+	// traceCallMany request.
+	maxTraceCallManyCalls = 256
 )
 
 var errTxNotFound = errors.New("transaction not found")
@@ -92,12 +96,13 @@ type Backend interface {
 
 // API is the collection of tracing APIs exposed over the private debugging endpoint.
 type API struct {
-	backend Backend
+	backend         Backend
+	stateMigrations state.Migrations // State migrations applied before replaying block transactions
 }
 
 // NewAPI creates a new API definition for the tracing methods of the Ethereum service.
 func NewAPI(backend Backend) *API {
-	return &API{backend: backend}
+	return &API{backend: backend, stateMigrations: state.InitMigrations(backend.ChainConfig())}
 }
 
 type chainContext struct {
@@ -181,6 +186,10 @@ type TraceConfig struct {
 	// Config specific to given tracer. Note struct logger
 	// config are historically embedded in main object.
 	TracerConfig json.RawMessage
+	// Migrations reports state migrations executed before the transactions
+	// of a block as leading entries of its trace. Disabled by default, so
+	// the trace results stay aligned with the block transactions.
+	Migrations bool
 }
 
 // TraceCallConfig is the config for traceCall API. It holds one more
@@ -200,8 +209,9 @@ type StdTraceConfig struct {
 
 // txTraceResult is the result of a single transaction trace.
 type txTraceResult struct {
-	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer
-	Error  string      `json:"error,omitempty"`  // Trace failure produced by the tracer
+	Result    interface{} `json:"result,omitempty"`    // Trace results produced by the tracer
+	Error     string      `json:"error,omitempty"`     // Trace failure produced by the tracer
+	Migration string      `json:"migration,omitempty"` // Name of the state migration executed before block transactions
 }
 
 // blockTraceTask represents a single block trace task when an entire chain is
@@ -291,6 +301,8 @@ func (api *API) traceChain(start, end *types.Block, config *TraceConfig, closed
 					signer   = types.MakeSigner(api.backend.ChainConfig(), task.block.Number())
 					blockCtx = core.NewEVMBlockContext(task.block.Header(), api.chainContext(ctx), nil)
 				)
+				migrations := api.applyMigrations(task.block, task.statedb, config)
+
 				// Trace all the transactions contained within
 				for i, tx := range task.block.Transactions() {
 					msg, _ := core.TransactionToMessage(tx, signer, task.block.BaseFee())
@@ -314,6 +326,7 @@ func (api *API) traceChain(start, end *types.Block, config *TraceConfig, closed
 				// state is the parent state of trace block, use block.number-1 as
 				// the state number.
 				tracker.releaseState(task.block.NumberU64()-1, task.release)
+				task.results = append(migrations, task.results...)
 
 				// Stream the result back to the result catcher or abort on teardown
 				select {
@@ -549,6 +562,8 @@ func (api *API) IntermediateRoots(ctx context.Context, hash common.Hash, config
 		vmctx              = core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
 		deleteEmptyObjects = chainConfig.IsEIP158(block.Number())
 	)
+	api.applyMigrations(block, statedb, nil)
+
 	for i, tx := range block.Transactions() {
 		if err := ctx.Err(); err != nil {
 			return nil, err
@@ -609,12 +624,18 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 	}
 	defer release()
 
+	migrations := api.applyMigrations(block, statedb, config)
+
 	// JS tracers have high overhead. In this case run a parallel
 	// process that generates states in one thread and traces txes
 	// in separate worker threads.
 	if config != nil && config.Tracer != nil && *config.Tracer != "" {
 		if isJS := DefaultDirectory.IsJS(*config.Tracer); isJS {
-			return api.traceBlockParallel(ctx, block, statedb, config)
+			results, err := api.traceBlockParallel(ctx, block, statedb, config)
+			if err != nil {
+				return nil, err
+			}
+			return append(migrations, results...), nil
 		}
 	}
 	// Native tracers have low overhead
@@ -644,7 +665,21 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 		// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
 		statedb.Finalise(is158)
 	}
-	return results, nil
+	return append(migrations, results...), nil
+}
+
+// applyMigrations executes state migrations scheduled for the block on top of its
+// parent state, the same way the state processor does before any transaction.
+// Executed migrations are reported as synthetic steps of the block trace only
+// if requested by the trace config.
+func (api *API) applyMigrations(block *types.Block, statedb *state.StateDB, config *TraceConfig) []*txTraceResult {
+	var results []*txTraceResult
+	for _, migration := range api.stateMigrations.Execute(block.Number(), statedb, "tracer") {
+		if config != nil && config.Migrations {
+			results = append(results, &txTraceResult{Migration: migration.Name()})
+		}
+	}
+	return results
 }
 
 // traceBlockParallel is for tracers that have a high overhead (read JS tracers). One thread
@@ -751,6 +786,8 @@ func (api *API) standardTraceBlockToFile(ctx context.Context, block *types.Block
 	}
 	defer release()
 
+	api.applyMigrations(block, statedb, nil)
+
 	// Retrieve the tracing configurations, or use default values
 	var (
 		logConfig logger.Config
@@ -888,6 +925,97 @@ func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *
 // created during the execution of EVM if the given transaction was added on
 // top of the provided block and returns them as a JSON object.
 func (api *API) TraceCall(ctx context.Context, args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, config *TraceCallConfig) (interface{}, error) {
+	block, statedb, vmctx, release, err := api.callEnv(ctx, blockNrOrHash, config)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Execute the trace
+	msg, err := args.ToMessage(api.backend.RPCGasCap(), block.BaseFee())
+	if err != nil {
+		return nil, err
+	}
+
+	var traceConfig *TraceConfig
+	if config != nil {
+		traceConfig = &config.TraceConfig
+	}
+	return api.traceTx(ctx, msg, new(Context), vmctx, statedb, traceConfig)
+}
+
+// TraceCallManyArgs is a call traced by traceCallMany along with the tracer
+// config specific to it.
+type TraceCallManyArgs struct {
+	Call   ethapi.TransactionArgs `json:"call"`
+	Config *TraceConfig           `json:"config"` // Tracer config, overriding the shared one
+}
+
+// TraceCallMany lets you trace a sequence of eth_calls executed one after the
+// other on top of the provided block, each call seeing the state changes made
+// by the previous ones. The state and block overrides of the config are applied
+// once before the first call, while its tracer config is used for the calls
+// without their own. The timeout of the shared config applies to the whole
+// sequence. It returns one trace per call.
+func (api *API) TraceCallMany(ctx context.Context, calls []TraceCallManyArgs, blockNrOrHash rpc.BlockNumberOrHash, config *TraceCallConfig) ([]interface{}, error) {
+	if len(calls) == 0 {
+		return nil, errors.New("no calls to trace")
+	}
+	if len(calls) > maxTraceCallManyCalls {
+		return nil, fmt.Errorf("too many calls to trace: %d > %d", len(calls), maxTraceCallManyCalls)
+	}
+	timeout := defaultTraceTimeout
+	if config != nil && config.Timeout != nil {
+		var err error
+		if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
+			return nil, err
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	block, statedb, vmctx, release, err := api.callEnv(ctx, blockNrOrHash, config)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var (
+		results = make([]interface{}, len(calls))
+		shared  *TraceConfig
+	)
+	if config != nil {
+		shared = &config.TraceConfig
+	}
+	for i, call := range calls {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("call %d: execution timeout", i)
+		}
+		msg, err := call.Call.ToMessage(api.backend.RPCGasCap(), block.BaseFee())
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		traceConfig := shared
+		if call.Config != nil {
+			traceConfig = call.Config
+		}
+		txctx := &Context{
+			BlockHash:   block.Hash(),
+			BlockNumber: block.Number(),
+			TxIndex:     i,
+		}
+		if results[i], err = api.traceTx(ctx, msg, txctx, vmctx, statedb, traceConfig); err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		// Finalize the state so the next call sees the changes as a new transaction
+		statedb.Finalise(api.backend.ChainConfig().IsEIP158(vmctx.BlockNumber))
+	}
+	return results, nil
+}
+
+// callEnv retrieves the block to execute calls on top of, along with its state
+// and the block context, with the overrides of the config applied.
+func (api *API) callEnv(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, config *TraceCallConfig) (*types.Block, *state.StateDB, vm.BlockContext, StateReleaseFunc, error) {
 	// Try to retrieve the specified block
 	var (
 		err   error
@@ -902,14 +1030,14 @@ func (api *API) TraceCall(ctx context.Context, args ethapi.TransactionArgs, bloc
 			// more flexibility and stability than trying to trace on 'pending', since
 			// the contents of 'pending' is unstable and probably not a true representation
 			// of what the next actual block is likely to contain.
-			return nil, errors.New("tracing on top of pending is not supported")
+			return nil, nil, vm.BlockContext{}, nil, errors.New("tracing on top of pending is not supported")
 		}
 		block, err = api.blockByNumber(ctx, number)
 	} else {
-		return nil, errors.New("invalid arguments; neither block nor hash specified")
+		return nil, nil, vm.BlockContext{}, nil, errors.New("invalid arguments; neither block nor hash specified")
 	}
 	if err != nil {
-		return nil, err
+		return nil, nil, vm.BlockContext{}, nil, err
 	}
 	// try to recompute the state
 	reexec := defaultTraceReexec
@@ -918,29 +1046,18 @@ func (api *API) TraceCall(ctx context.Context, args ethapi.TransactionArgs, bloc
 	}
 	statedb, release, err := api.backend.StateAtBlock(ctx, block, reexec, nil, true, false)
 	if err != nil {
-		return nil, err
+		return nil, nil, vm.BlockContext{}, nil, err
 	}
-	defer release()
-
 	vmctx := core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
 	// Apply the customization rules if required.
 	if config != nil {
 		if err := config.StateOverrides.Apply(statedb); err != nil {
-			return nil, err
+			release()
+			return nil, nil, vm.BlockContext{}, nil, err
 		}
 		config.BlockOverrides.Apply(&vmctx)
 	}
-	// Execute the trace
-	msg, err := args.ToMessage(api.backend.RPCGasCap(), block.BaseFee())
-	if err != nil {
-		return nil, err
-	}
-
-	var traceConfig *TraceConfig
-	if config != nil {
-		traceConfig = &config.TraceConfig
-	}
-	return api.traceTx(ctx, msg, new(Context), vmctx, statedb, traceConfig)
+	return block, statedb, vmctx, release, nil
 }
 
 // traceTx configures a new tracer according to the provided configuration, and
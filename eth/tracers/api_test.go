@@ -26,15 +26,18 @@ import (
 	"math/big"
 	"reflect"
 	"sort"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/mint"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -321,6 +324,65 @@ func TestTraceCall(t *testing.T) {
 	}
 }
 
+func TestTraceCallMany(t *testing.T) {
+	t.Parallel()
+
+	accounts := newAccounts(1)
+	config := *params.TestChainConfig
+	config.CepheusBlock = big.NewInt(0)
+	genesis := &core.Genesis{
+		Config: &config,
+		Alloc:  core.GenesisAlloc{accounts[0].addr: {Balance: big.NewInt(params.Ether)}},
+	}
+	backend := newTestBackend(t, 1, genesis, func(i int, b *core.BlockGen) {})
+	defer backend.teardown()
+	api := NewAPI(backend)
+
+	// Increments slot 0 and returns the new value
+	counter := common.Address{0xcc}
+	code := hexutil.Bytes(common.FromHex("6000546001018060005560005260206000f3"))
+	calls := []TraceCallManyArgs{
+		{Call: ethapi.TransactionArgs{From: &accounts[0].addr, To: &counter}},
+		{Call: ethapi.TransactionArgs{From: &accounts[0].addr, To: &counter}, Config: &TraceConfig{Config: &logger.Config{Limit: 2}}},
+	}
+	traceConfig := &TraceCallConfig{
+		StateOverrides: &ethapi.StateOverride{counter: {Code: &code}},
+	}
+	results, err := api.TraceCallMany(context.Background(), calls, rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber), traceConfig)
+	if err != nil {
+		t.Fatalf("failed to trace calls: %v", err)
+	}
+	if len(results) != len(calls) {
+		t.Fatalf("result count mismatch: have %d, want %d", len(results), len(calls))
+	}
+	for i, result := range results {
+		var have *logger.ExecutionResult
+		if err := json.Unmarshal(result.(json.RawMessage), &have); err != nil {
+			t.Fatalf("call %d: failed to unmarshal result %v", i, err)
+		}
+		// Every call sees the storage written by the previous one
+		if want := fmt.Sprintf("%064x", i+1); have.ReturnValue != want {
+			t.Errorf("call %d: return value mismatch: have %s, want %s", i, have.ReturnValue, want)
+		}
+		// The tracer config of the call overrides the shared one
+		if want := []int{12, 2}[i]; len(have.StructLogs) != want {
+			t.Errorf("call %d: struct log count mismatch: have %d, want %d", i, len(have.StructLogs), want)
+		}
+	}
+	if _, err := api.TraceCallMany(context.Background(), nil, rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber), nil); err == nil {
+		t.Errorf("expected error on empty call list")
+	}
+	if _, err := api.TraceCallMany(context.Background(), make([]TraceCallManyArgs, maxTraceCallManyCalls+1), rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber), nil); err == nil {
+		t.Errorf("expected error on too many calls")
+	}
+	// The timeout applies to the whole sequence
+	timeout := "0s"
+	traceConfig.Timeout = &timeout
+	if _, err := api.TraceCallMany(context.Background(), calls, rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber), traceConfig); err == nil || !strings.Contains(err.Error(), "execution timeout") {
+		t.Errorf("timeout error mismatch: have %v, want execution timeout", err)
+	}
+}
+
 func TestTraceTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -450,6 +512,56 @@ func TestTraceBlock(t *testing.T) {
 	}
 }
 
+func TestTraceBlockMigrations(t *testing.T) {
+	t.Parallel()
+
+	accounts := newAccounts(1)
+	config := *params.TestChainConfig
+	config.CepheusBlock = big.NewInt(0)
+	config.MintContract = &params.MintContractConfig{
+		ActivationBlock: big.NewInt(2),
+		OwnerAddress:    accounts[0].addr,
+		MintLimit:       (*math.HexOrDecimal256)(big.NewInt(params.Ether)),
+	}
+	genesis := &core.Genesis{
+		Config: &config,
+		Alloc: core.GenesisAlloc{
+			accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+		},
+	}
+	signer := types.HomesteadSigner{}
+	backend := newTestBackend(t, 2, genesis, func(i int, b *core.BlockGen) {
+		// Mint right after the mint contract is deployed by the migration
+		var data []byte
+		if i == 1 {
+			data = append(append(common.BigToHash(big.NewInt(1)).Bytes(), common.Hash{}.Bytes()...), mint.BurnNetworkEthereum)
+		}
+		tx, _ := types.SignTx(types.NewTransaction(uint64(i), mint.Contract.Address, new(big.Int), 150000, b.BaseFee(), data), signer, accounts[0].key)
+		b.AddTx(tx)
+	})
+	defer backend.chain.Stop()
+	api := NewAPI(backend)
+
+	for i, tc := range []struct {
+		number rpc.BlockNumber
+		config *TraceConfig
+		want   string
+	}{
+		{1, nil, `[{"result":{"gas":21000,"failed":false,"returnValue":"","structLogs":[]}}]`},
+		{2, nil, `[{"result":{"gas":121272,"failed":false,"returnValue":"","structLogs":[]}}]`},
+		{2, &TraceConfig{Migrations: true}, `[{"migration":"mint contract initialization"},{"result":{"gas":121272,"failed":false,"returnValue":"","structLogs":[]}}]`},
+	} {
+		result, err := api.TraceBlockByNumber(context.Background(), tc.number, tc.config)
+		if err != nil {
+			t.Fatalf("test %d: want no error, have %v", i, err)
+		}
+		have, _ := json.Marshal(result)
+		if string(have) != tc.want {
+			t.Errorf("test %d: result mismatch, have\n%v\n, want\n%v\n", i, string(have), tc.want)
+		}
+	}
+}
+
 func TestTracingWithOverrides(t *testing.T) {
 	t.Parallel()
 	// Initialize test accounts
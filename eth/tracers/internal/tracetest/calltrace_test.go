@@ -28,6 +28,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/mint"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -377,3 +378,122 @@ func TestInternals(t *testing.T) {
 		}
 	}
 }
+
+// jsMintTracer reports the type, value and error of the top-level frame.
+const jsMintTracer = `{fault: function() {}, result: function(ctx) { return {type: ctx.type, value: ctx.value.toString(), error: ctx.error}; }}`
+
+// TestMintInstruction tests that natively executed mint instructions are
+// reflected by the call, JS and prestate tracers.
+func TestMintInstruction(t *testing.T) {
+	var (
+		owner      = common.HexToAddress("0x000000000000000000000000000000000000feed")
+		amount     = big.NewInt(1000)
+		burnTxHash = common.HexToHash("0x621c759718a44e19ad04f8d133746b1043a2004f3fd68028cd28f1598388106e")
+		input      = append(append(common.BigToHash(amount).Bytes(), burnTxHash.Bytes()...), mint.BurnNetworkTron)
+		txContext  = vm.TxContext{
+			Origin:   owner,
+			GasPrice: big.NewInt(0),
+		}
+		context = vm.BlockContext{
+			CanTransfer: core.CanTransfer,
+			Transfer:    core.Transfer,
+			Coinbase:    common.Address{},
+			BlockNumber: new(big.Int).SetUint64(8000000),
+			Time:        5,
+			Difficulty:  big.NewInt(0x30000),
+			GasLimit:    uint64(6000000),
+		}
+		config = *params.MainnetChainConfig
+	)
+	config.MintContract = &params.MintContractConfig{UniqueBurnTxBlock: big.NewInt(0)}
+
+	mkTracer := func(name string, cfg json.RawMessage) tracers.Tracer {
+		tr, err := tracers.DefaultDirectory.New(name, nil, cfg)
+		if err != nil {
+			t.Fatalf("failed to create call tracer: %v", err)
+		}
+		return tr
+	}
+	for _, tc := range []struct {
+		name   string
+		limit  *big.Int
+		tracer tracers.Tracer
+		want   string
+	}{
+		{
+			name:   "Call-tracer - successful mint",
+			limit:  big.NewInt(5000),
+			tracer: mkTracer("callTracer", json.RawMessage(`{ "withLog": true }`)),
+			want:   `{"from":"0x000000000000000000000000000000000000feed","gas":"0x249f0","gasUsed":"0x1e22c","to":"0x0000000000000000000000000000000000001000","input":"0x00000000000000000000000000000000000000000000000000000000000003e8621c759718a44e19ad04f8d133746b1043a2004f3fd68028cd28f1598388106e01","logs":[{"address":"0x0000000000000000000000000000000000001000","topics":["0x0d9811f14a9cfa628d4819902adcdd4ff09f73ac9c2628280058dc2146fa247d"],"data":"0x00000000000000000000000000000000000000000000000000000000000003e8621c759718a44e19ad04f8d133746b1043a2004f3fd68028cd28f1598388106e0000000000000000000000000000000000000000000000000000000000000001"}],"value":"0x3e8","type":"MINT"}`,
+		},
+		{
+			name:   "Call-tracer - mint limit exceeded",
+			limit:  big.NewInt(500),
+			tracer: mkTracer("callTracer", json.RawMessage(`{ "withLog": true }`)),
+			want:   `{"from":"0x000000000000000000000000000000000000feed","gas":"0x249f0","gasUsed":"0x1e22c","to":"0x0000000000000000000000000000000000001000","input":"0x00000000000000000000000000000000000000000000000000000000000003e8621c759718a44e19ad04f8d133746b1043a2004f3fd68028cd28f1598388106e01","error":"mint amount exceeds mint limit","value":"0x0","type":"MINT"}`,
+		},
+		{
+			name:   "JS-tracer - successful mint",
+			limit:  big.NewInt(5000),
+			tracer: mkTracer(jsMintTracer, nil),
+			want:   `{"type":"MINT","value":"1000"}`,
+		},
+		{
+			name:   "JS-tracer - mint limit exceeded",
+			limit:  big.NewInt(500),
+			tracer: mkTracer(jsMintTracer, nil),
+			want:   `{"type":"MINT","value":"0","error":"mint amount exceeds mint limit"}`,
+		},
+		{
+			name:   "Flat-call-tracer - successful mint",
+			limit:  big.NewInt(5000),
+			tracer: mkTracer("flatCallTracer", nil),
+			want:   `[{"action":{"from":"0x000000000000000000000000000000000000feed","gas":"0x249f0","input":"0x00000000000000000000000000000000000000000000000000000000000003e8621c759718a44e19ad04f8d133746b1043a2004f3fd68028cd28f1598388106e01","to":"0x0000000000000000000000000000000000001000","value":"0x3e8"},"blockHash":null,"blockNumber":0,"result":{"gasUsed":"0x1e22c","output":"0x"},"subtraces":0,"traceAddress":[],"transactionHash":null,"transactionPosition":0,"type":"mint"}]`,
+		},
+		{
+			name:   "Prestate-tracer - successful mint",
+			limit:  big.NewInt(5000),
+			tracer: mkTracer("prestateTracer", json.RawMessage(`{ "diffMode": true }`)),
+			want:   `{"post":{"0x0000000000000000000000000000000000001000":{"storage":{"0x0000000000000000000000000000000000000000000000000000000000000001":"0x0000000000000000000000000000000000000000000000000000000000000fa0","0x2167dd1636d0bb1e71c3aa5c2d197ccde5b2e61fd579e8beac436457c01de052":"0x0000000000000000000000000000000000000000000000000000000000000001"}},"0x000000000000000000000000000000000000feed":{"balance":"0x1c6bf526343e8","nonce":1}},"pre":{"0x0000000000000000000000000000000000001000":{"balance":"0x0","code":"0x608060405234801561001057600080fd5b506004361061004c5760003560e01c806334259b5c146100515780638da5cb5b1461006d578063996517cf1461008b578063f2fde38b146100a9575b600080fd5b61006b60048036038101906100669190610346565b6100c5565b005b6100756101a1565b60405161008291906103b4565b60405180910390f35b6100936101c5565b6040516100a091906103de565b60405180910390f35b6100c360048036038101906100be9190610425565b6101cb565b005b60008054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff1614610153576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161014a906104af565b60405180910390fd5b6001548110610197576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161018e9061051b565b60405180910390fd5b8060018190555050565b60008054906101000a900473ffffffffffffffffffffffffffffffffffffffff1681565b60015481565b60008054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff1614610259576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610250906104af565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16036102c8576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016102bf906105ad565b60405180910390fd5b806000806101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555050565b600080fd5b6000819050919050565b61032381610310565b811461032e57600080fd5b50565b6000813590506103408161031a565b92915050565b60006020828403121561035c5761035b61030b565b5b600061036a84828501610331565b91505092915050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b600061039e82610373565b9050919050565b6103ae81610393565b82525050565b60006020820190506103c960008301846103a5565b92915050565b6103d881610310565b82525050565b60006020820190506103f360008301846103cf565b92915050565b61040281610393565b811461040d57600080fd5b50565b60008135905061041f816103f9565b92915050565b60006020828403121561043b5761043a61030b565b5b600061044984828501610410565b91505092915050565b600082825260208201905092915050565b7f417661696c61626c65206f6e6c7920666f72206f776e65720000000000000000600082015250565b6000610499601883610452565b91506104a482610463565b602082019050919050565b600060208201905081810360008301526104c88161048c565b9050919050565b7f4d696e74206c696d69742063616e6e6f7420626520696e637265617365640000600082015250565b6000610505601e83610452565b9150610510826104cf565b602082019050919050565b60006020820190508181036000830152610534816104f8565b9050919050565b7f5a65726f2061646472657373206973206e6f7420612076616c6964206f776e6560008201527f7200000000000000000000000000000000000000000000000000000000000000602082015250565b6000610597602183610452565b91506105a28261053b565b604082019050919050565b600060208201905081810360008301526105c68161058a565b905091905056fea2646970667358221220f6ba05603c2183eab4fcaf34ef756bc7b9865308ce3373edd3d9ecd4ecef228864736f6c63430008130033","storage":{"0x0000000000000000000000000000000000000000000000000000000000000001":"0x0000000000000000000000000000000000000000000000000000000000001388"}},"0x000000000000000000000000000000000000feed":{"balance":"0x1c6bf52634000"}}}`,
+		},
+	} {
+		_, statedb := tests.MakePreState(rawdb.NewMemoryDatabase(),
+			core.GenesisAlloc{
+				mint.Contract.Address: core.GenesisAccount{
+					Code: mint.Contract.Bytecode,
+					Storage: map[common.Hash]common.Hash{
+						mint.Contract.StorageLayout.Owner:     owner.Hash(),
+						mint.Contract.StorageLayout.MintLimit: common.BigToHash(tc.limit),
+					},
+					Balance: new(big.Int),
+				},
+				owner: core.GenesisAccount{
+					Balance: big.NewInt(500000000000000),
+				},
+			}, false)
+		evm := vm.NewEVM(context, txContext, statedb, &config, vm.Config{Tracer: tc.tracer})
+		msg := &core.Message{
+			To:                &mint.Contract.Address,
+			From:              owner,
+			Value:             big.NewInt(0),
+			GasLimit:          150000,
+			GasPrice:          big.NewInt(0),
+			GasFeeCap:         big.NewInt(0),
+			GasTipCap:         big.NewInt(0),
+			Data:              input,
+			SkipAccountChecks: false,
+		}
+		st := core.NewStateTransition(evm, msg, new(core.GasPool).AddGas(msg.GasLimit))
+		if _, err := st.TransitionDb(); err != nil {
+			t.Fatalf("test %v: failed to execute transaction: %v", tc.name, err)
+		}
+		// Retrieve the trace result and compare against the expected
+		res, err := tc.tracer.GetResult()
+		if err != nil {
+			t.Fatalf("test %v: failed to retrieve trace result: %v", tc.name, err)
+		}
+		if string(res) != tc.want {
+			t.Fatalf("test %v: trace mismatch\n have: %v\n want: %v\n", tc.name, string(res), tc.want)
+		}
+	}
+}
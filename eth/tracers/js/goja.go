@@ -26,6 +26,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/mint"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers"
@@ -229,6 +230,11 @@ func (t *jsTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Addr
 	t.dbValue = db.setupObject()
 	if create {
 		t.ctx["type"] = t.vm.ToValue("CREATE")
+	} else if env.IsMintInstruction(to, input) {
+		// Mint instructions are executed natively, the context value is the credited amount
+		instruction, _ := mint.DecodeInstruction(input)
+		t.ctx["type"] = t.vm.ToValue("MINT")
+		value = instruction.Amount
 	} else {
 		t.ctx["type"] = t.vm.ToValue("CALL")
 	}
@@ -291,6 +297,16 @@ func (t *jsTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
 	t.ctx["output"] = t.vm.ToValue(output)
 	if err != nil {
 		t.ctx["error"] = t.vm.ToValue(err.Error())
+
+		// Nothing is credited by a failed mint
+		if t.ctx["type"].String() == "MINT" {
+			valueBig, err := t.toBig(t.vm, "0")
+			if err != nil {
+				t.err = err
+				return
+			}
+			t.ctx["value"] = valueBig
+		}
 	}
 }
 
@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/mint"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 )
@@ -43,6 +44,7 @@ type callLog struct {
 
 type callFrame struct {
 	Type         vm.OpCode       `json:"-"`
+	mint         bool            // Set for top-level frames executing a mint instruction (see vm.EVM.Mint)
 	From         common.Address  `json:"from"`
 	Gas          uint64          `json:"gas"`
 	GasUsed      uint64          `json:"gasUsed"`
@@ -59,6 +61,9 @@ type callFrame struct {
 }
 
 func (f callFrame) TypeString() string {
+	if f.mint {
+		return "MINT"
+	}
 	return f.Type.String()
 }
 
@@ -138,12 +143,32 @@ func (t *callTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Ad
 	}
 	if create {
 		t.callstack[0].Type = vm.CREATE
+	} else if env.IsMintInstruction(to, input) {
+		// Mint instructions are executed natively, the frame value is the credited amount
+		instruction, _ := mint.DecodeInstruction(input)
+		t.callstack[0].mint = true
+		t.callstack[0].Value = instruction.Amount
 	}
 }
 
 // CaptureEnd is called after the call finishes to finalize the tracing.
 func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
 	t.callstack[0].processOutput(output, err)
+
+	// Nothing is credited by a failed mint
+	if t.callstack[0].mint && err != nil {
+		t.callstack[0].Value = new(big.Int)
+	}
+	// Mint event is emitted natively, so it is not captured via opcode processing
+	if t.callstack[0].mint && err == nil && t.config.WithLog {
+		instruction, _ := mint.DecodeInstruction(t.callstack[0].Input)
+		data, packErr := mint.PackEvent(instruction.Amount, instruction.BurnTxHash, instruction.BurnTxNetwork)
+		if packErr != nil {
+			return
+		}
+		log := callLog{Address: mint.Contract.Address, Topics: []common.Hash{mint.EventID()}, Data: data}
+		t.callstack[0].Logs = append(t.callstack[0].Logs, log)
+	}
 }
 
 // CaptureState implements the EVMLogger interface to trace a single step of VM execution.
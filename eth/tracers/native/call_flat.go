@@ -248,7 +248,11 @@ func flatFromNested(input *callFrame, traceAddress []int, convertErrs bool, ctx
 	case vm.SELFDESTRUCT:
 		frame = newFlatSuicide(input)
 	case vm.CALL, vm.STATICCALL, vm.CALLCODE, vm.DELEGATECALL:
-		frame = newFlatCall(input)
+		if input.mint {
+			frame = newFlatMint(input)
+		} else {
+			frame = newFlatCall(input)
+		}
 	default:
 		return nil, fmt.Errorf("unrecognized call frame type: %s", input.Type)
 	}
@@ -328,6 +332,14 @@ func newFlatCall(input *callFrame) *flatCallFrame {
 	}
 }
 
+// newFlatMint returns a frame of a mint instruction, its value is the credited amount.
+func newFlatMint(input *callFrame) *flatCallFrame {
+	frame := newFlatCall(input)
+	frame.Type = "mint"
+	frame.Action.CallType = ""
+	return frame
+}
+
 func newFlatSuicide(input *callFrame) *flatCallFrame {
 	return &flatCallFrame{
 		Type: "suicide",
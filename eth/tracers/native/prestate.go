@@ -24,6 +24,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/mint"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers"
@@ -114,6 +115,15 @@ func (t *prestateTracer) CaptureStart(env *vm.EVM, from common.Address, to commo
 	if create && t.config.DiffMode {
 		t.created[to] = true
 	}
+	// Mint instructions access mint contract storage natively, bypassing opcode processing
+	if !create && env.IsMintInstruction(to, input) {
+		t.lookupStorage(mint.Contract.Address, mint.Contract.StorageLayout.Owner)
+		t.lookupStorage(mint.Contract.Address, mint.Contract.StorageLayout.MintLimit)
+		if env.ChainConfig().IsUniqueBurnTx(env.Context.BlockNumber) {
+			instruction, _ := mint.DecodeInstruction(input)
+			t.lookupStorage(mint.Contract.Address, mint.BurnTxSlot(instruction.BurnTxNetwork, instruction.BurnTxHash))
+		}
+	}
 }
 
 // CaptureEnd is called after the call finishes to finalize the tracing.
@@ -334,6 +334,41 @@ func (t *Transaction) EffectiveTip(ctx context.Context) (*hexutil.Big, error) {
 	return (*hexutil.Big)(tip), nil
 }
 
+// fees returns the fee credited to the block fee recipient and the burnt fee
+// of a mined transaction.
+func (t *Transaction) fees(ctx context.Context) (*big.Int, *big.Int, error) {
+	tx, block, err := t.resolve(ctx)
+	if err != nil || tx == nil || block == nil {
+		return nil, nil, err
+	}
+	header, err := block.resolveHeader(ctx)
+	if err != nil || header == nil {
+		return nil, nil, err
+	}
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, nil, err
+	}
+	fee, burnt := ethapi.SplitTransactionFee(tx, receipt.GasUsed, header.BaseFee)
+	return fee, burnt, nil
+}
+
+func (t *Transaction) Fee(ctx context.Context) (*hexutil.Big, error) {
+	fee, _, err := t.fees(ctx)
+	if err != nil || fee == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(fee), nil
+}
+
+func (t *Transaction) BurntFee(ctx context.Context) (*hexutil.Big, error) {
+	_, burnt, err := t.fees(ctx)
+	if err != nil || burnt == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(burnt), nil
+}
+
 func (t *Transaction) Value(ctx context.Context) (hexutil.Big, error) {
 	tx, _, err := t.resolve(ctx)
 	if err != nil || tx == nil {
@@ -573,6 +608,29 @@ func (t *Transaction) RawReceipt(ctx context.Context) (hexutil.Bytes, error) {
 
 type BlockType int
 
+// FeeRecipient represents the sum of transaction fees credited to a single
+// recipient in a block.
+type FeeRecipient struct {
+	r         *Resolver
+	recipient *ethapi.FeeRecipient
+}
+
+func (f *FeeRecipient) Account(ctx context.Context, args BlockNumberArgs) *Account {
+	return &Account{
+		r:             f.r,
+		address:       f.recipient.Address,
+		blockNrOrHash: args.NumberOrLatest(),
+	}
+}
+
+func (f *FeeRecipient) Type(ctx context.Context) string {
+	return f.recipient.Type
+}
+
+func (f *FeeRecipient) Amount(ctx context.Context) hexutil.Big {
+	return *f.recipient.Amount
+}
+
 // Block represents an Ethereum block.
 // backend, and numberOrHash are mandatory. All other fields are lazily fetched
 // when required.
@@ -900,6 +958,47 @@ func (b *Block) Miner(ctx context.Context, args BlockNumberArgs) (*Account, erro
 	}, nil
 }
 
+// fees returns the breakdown of transaction fees paid in the block.
+func (b *Block) fees(ctx context.Context) (*ethapi.BlockFees, error) {
+	block, err := b.resolve(ctx)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	receipts, err := b.resolveReceipts(ctx)
+	if err != nil || len(receipts) != len(block.Transactions()) {
+		return nil, err
+	}
+	return ethapi.NewBlockFees(b.r.backend, block, receipts), nil
+}
+
+func (b *Block) FeeRecipients(ctx context.Context) (*[]*FeeRecipient, error) {
+	fees, err := b.fees(ctx)
+	if err != nil || fees == nil {
+		return nil, err
+	}
+	ret := make([]*FeeRecipient, 0, len(fees.Recipients))
+	for _, recipient := range fees.Recipients {
+		ret = append(ret, &FeeRecipient{r: b.r, recipient: recipient})
+	}
+	return &ret, nil
+}
+
+func (b *Block) TotalFees(ctx context.Context) (*hexutil.Big, error) {
+	fees, err := b.fees(ctx)
+	if err != nil || fees == nil {
+		return nil, err
+	}
+	return fees.TotalFees, nil
+}
+
+func (b *Block) BurntFees(ctx context.Context) (*hexutil.Big, error) {
+	fees, err := b.fees(ctx)
+	if err != nil || fees == nil {
+		return nil, err
+	}
+	return fees.BurntFees, nil
+}
+
 func (b *Block) TransactionCount(ctx context.Context) (*int32, error) {
 	block, err := b.resolve(ctx)
 	if err != nil || block == nil {
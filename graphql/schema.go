@@ -100,6 +100,12 @@ const schema string = `
         maxPriorityFeePerGas: BigInt
         # EffectiveTip is the actual amount of reward going to miner after considering the max fee cap.
         effectiveTip: BigInt
+        # Fee is the amount credited to the block fee recipients for this transaction,
+        # in wei. If the transaction has not yet been mined, this field will be null.
+        fee: BigInt
+        # BurntFee is the base fee burnt by this transaction, in wei. If the
+        # transaction has not yet been mined, this field will be null.
+        burntFee: BigInt
         # Gas is the maximum amount of gas this transaction can consume.
         gas: Long!
         # InputData is the data supplied to the target of the transaction.
@@ -169,6 +175,17 @@ const schema string = `
         topics: [[Bytes32!]!]
     }
 
+    # FeeRecipient is the sum of transaction fees credited to a single recipient
+    # in a block.
+    type FeeRecipient {
+        # Account is the credited account.
+        account(block: Long): Account!
+        # Type is "collector" for fee collectors or "coinbase" for the block coinbase.
+        type: String!
+        # Amount is the credited amount, in wei.
+        amount: BigInt!
+    }
+
     # Block is an Ethereum block.
     type Block {
         # Number is the number of this block, starting at 0 for the genesis block.
@@ -200,6 +217,14 @@ const schema string = `
         baseFeePerGas: BigInt
         # NextBaseFeePerGas is the fee per unit of gas which needs to be burned in the next block.
         nextBaseFeePerGas: BigInt
+        # FeeRecipients is the list of accounts credited with transaction fees of
+        # this block along with credited amounts. If receipts of the block are
+        # not available, this field will be null.
+        feeRecipients: [FeeRecipient!]
+        # TotalFees is the sum of transaction fees credited to the fee recipients, in wei.
+        totalFees: BigInt
+        # BurntFees is the sum of base fees burnt by transactions in this block, in wei.
+        burntFees: BigInt
         # Timestamp is the unix timestamp at which this block was mined.
         timestamp: Long!
         # LogsBloom is a bloom filter that can be used to check if a block may
@@ -37,6 +37,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -860,6 +861,137 @@ func (s *BlockChainAPI) GetStorageAt(ctx context.Context, address common.Address
 	return res[:], state.Error()
 }
 
+// Fee recipient types reported by BlockFees.
+const (
+	FeeRecipientCollector = "collector"
+	FeeRecipientCoinbase  = "coinbase"
+)
+
+// BlockFees is the breakdown of transaction fees paid in a block.
+type BlockFees struct {
+	BlockHash    common.Hash       `json:"blockHash"`
+	BlockNumber  hexutil.Uint64    `json:"blockNumber"`
+	Recipients   []*FeeRecipient   `json:"recipients"`
+	TotalFees    *hexutil.Big      `json:"totalFees"`
+	BurntFees    *hexutil.Big      `json:"burntFees"`
+	Transactions []*TransactionFee `json:"transactions"`
+}
+
+// FeeRecipient is the sum of transaction fees credited to a single recipient
+// in a block. The recipient is either a fee collector or the block coinbase.
+type FeeRecipient struct {
+	Address common.Address `json:"address"`
+	Type    string         `json:"type"`
+	Amount  *hexutil.Big   `json:"amount"`
+}
+
+// TransactionFee is the fee paid by a single transaction. Fee is the amount
+// credited to the block fee recipients, BurntFee is the burnt base fee part.
+type TransactionFee struct {
+	TxHash            common.Hash    `json:"transactionHash"`
+	TxIndex           hexutil.Uint64 `json:"transactionIndex"`
+	GasUsed           hexutil.Uint64 `json:"gasUsed"`
+	EffectiveGasPrice *hexutil.Big   `json:"effectiveGasPrice"`
+	Fee               *hexutil.Big   `json:"fee"`
+	BurntFee          *hexutil.Big   `json:"burntFee"`
+}
+
+// SplitTransactionFee returns the fee credited to the block fee recipients and
+// the burnt fee of a transaction, which used the given amount of gas.
+func SplitTransactionFee(tx *types.Transaction, gasUsed uint64, baseFee *big.Int) (fee *big.Int, burnt *big.Int) {
+	gas := new(big.Int).SetUint64(gasUsed)
+	if baseFee == nil {
+		return new(big.Int).Mul(gas, tx.GasPrice()), new(big.Int)
+	}
+	tip := math.BigMin(tx.GasTipCap(), new(big.Int).Sub(tx.GasFeeCap(), baseFee))
+	return new(big.Int).Mul(gas, tip), new(big.Int).Mul(gas, baseFee)
+}
+
+// NewBlockFees calculates the breakdown of transaction fees paid in the block.
+// Block transactions and receipts have to have the same order.
+func NewBlockFees(b Backend, block *types.Block, receipts types.Receipts) *BlockFees {
+	var (
+		header     = block.Header()
+		txs        = block.Transactions()
+		total      = new(big.Int)
+		burnt      = new(big.Int)
+		fees       = make([]*TransactionFee, len(txs))
+		recipients []*FeeRecipient
+	)
+	// Coinbase is resolved by consensus engine, as it is done during block processing
+	coinbase, err := b.Engine().Author(header)
+	if err != nil {
+		coinbase = header.Coinbase
+	}
+	add := func(credit core.FeeCredit) {
+		kind := FeeRecipientCollector
+		if credit.Coinbase {
+			kind = FeeRecipientCoinbase
+		}
+		for _, recipient := range recipients {
+			if recipient.Address == credit.Recipient && recipient.Type == kind {
+				recipient.Amount.ToInt().Add(recipient.Amount.ToInt(), credit.Amount)
+				return
+			}
+		}
+		recipients = append(recipients, &FeeRecipient{Address: credit.Recipient, Type: kind, Amount: (*hexutil.Big)(new(big.Int).Set(credit.Amount))})
+	}
+	for i, tx := range txs {
+		fee, burntFee := SplitTransactionFee(tx, receipts[i].GasUsed, header.BaseFee)
+		total.Add(total, fee)
+		burnt.Add(burnt, burntFee)
+		for _, credit := range core.SplitFee(b.ChainConfig(), header.Number, coinbase, fee) {
+			add(credit)
+		}
+		price := tx.GasPrice()
+		if header.BaseFee != nil {
+			price = math.BigMin(new(big.Int).Add(tx.GasTipCap(), header.BaseFee), tx.GasFeeCap())
+		}
+		fees[i] = &TransactionFee{
+			TxHash:            tx.Hash(),
+			TxIndex:           hexutil.Uint64(i),
+			GasUsed:           hexutil.Uint64(receipts[i].GasUsed),
+			EffectiveGasPrice: (*hexutil.Big)(price),
+			Fee:               (*hexutil.Big)(fee),
+			BurntFee:          (*hexutil.Big)(burntFee),
+		}
+	}
+	// Blocks without transactions still report the recipients they would credit
+	if len(txs) == 0 {
+		for _, credit := range core.SplitFee(b.ChainConfig(), header.Number, coinbase, new(big.Int)) {
+			add(credit)
+		}
+	}
+	return &BlockFees{
+		BlockHash:    block.Hash(),
+		BlockNumber:  hexutil.Uint64(block.NumberU64()),
+		Recipients:   recipients,
+		TotalFees:    (*hexutil.Big)(total),
+		BurntFees:    (*hexutil.Big)(burnt),
+		Transactions: fees,
+	}
+}
+
+// GetBlockFees returns the breakdown of transaction fees paid in the requested
+// block, including the recipients credited with them (fee collectors or coinbase).
+func (s *BlockChainAPI) GetBlockFees(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*BlockFees, error) {
+	if number, ok := blockNrOrHash.Number(); ok && number == rpc.PendingBlockNumber {
+		return nil, errors.New("fees of pending block are not available")
+	}
+	block, err := s.b.BlockByNumberOrHash(ctx, blockNrOrHash)
+	if block == nil || err != nil {
+		return nil, err
+	}
+	receipts, err := s.b.GetReceipts(ctx, block.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if len(receipts) != len(block.Transactions()) {
+		return nil, fmt.Errorf("receipts are not available for block %d", block.NumberU64())
+	}
+	return NewBlockFees(s.b, block, receipts), nil
+}
+
 // OverrideAccount indicates the overriding fields of account during the execution
 // of a message call.
 // Note, state and stateDiff can't be specified at the same time. If state is
@@ -955,6 +1087,34 @@ func (diff *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
 	}
 }
 
+// applyHeader overrides the given header fields into the given header.
+func (diff *BlockOverrides) applyHeader(header *types.Header) {
+	if diff == nil {
+		return
+	}
+	if diff.Number != nil {
+		header.Number = diff.Number.ToInt()
+	}
+	if diff.Difficulty != nil {
+		header.Difficulty = diff.Difficulty.ToInt()
+	}
+	if diff.Time != nil {
+		header.Time = uint64(*diff.Time)
+	}
+	if diff.GasLimit != nil {
+		header.GasLimit = uint64(*diff.GasLimit)
+	}
+	if diff.Coinbase != nil {
+		header.Coinbase = *diff.Coinbase
+	}
+	if diff.Random != nil {
+		header.MixDigest = *diff.Random
+	}
+	if diff.BaseFee != nil {
+		header.BaseFee = diff.BaseFee.ToInt()
+	}
+}
+
 func DoCall(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *StateOverride, timeout time.Duration, globalGasCap uint64) (*core.ExecutionResult, error) {
 	defer func(start time.Time) { log.Debug("Executing EVM call finished", "runtime", time.Since(start)) }(time.Now())
 
@@ -977,6 +1137,14 @@ func DoCall(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash
 	// this makes sure resources are cleaned up.
 	defer cancel()
 
+	gp := new(core.GasPool).AddGas(math.MaxUint64)
+	return applyCall(ctx, b, args, state, header, nil, gp, timeout, globalGasCap)
+}
+
+// applyCall executes the given call on top of the state, in the context of the
+// given header. If coinbase is set, it replaces the block author derived from the
+// header. The context is expected to be cancelled by the caller.
+func applyCall(ctx context.Context, b Backend, args TransactionArgs, state *state.StateDB, header *types.Header, coinbase *common.Address, gp *core.GasPool, timeout time.Duration, globalGasCap uint64) (*core.ExecutionResult, error) {
 	// Get a new instance of the EVM.
 	msg, err := args.ToMessage(globalGasCap, header.BaseFee)
 	if err != nil {
@@ -986,6 +1154,9 @@ func DoCall(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash
 	if err != nil {
 		return nil, err
 	}
+	if coinbase != nil {
+		evm.Context.Coinbase = *coinbase
+	}
 	// Wait for the context to be done and cancel the evm. Even if the
 	// EVM has finished, cancelling may be done (repeatedly)
 	go func() {
@@ -994,7 +1165,6 @@ func DoCall(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash
 	}()
 
 	// Execute the message.
-	gp := new(core.GasPool).AddGas(math.MaxUint64)
 	result, err := core.ApplyMessage(evm, msg, gp)
 	if err := vmError(); err != nil {
 		return nil, err
@@ -1745,15 +1915,9 @@ func (s *TransactionAPI) sign(addr common.Address, tx *types.Transaction) (*type
 
 // SubmitTransaction is a helper function that submits tx to txPool and logs a message.
 func SubmitTransaction(ctx context.Context, b Backend, tx *types.Transaction) (common.Hash, error) {
-	// If the transaction fee cap is already specified, ensure the
-	// fee of the given transaction is _reasonable_.
-	if err := checkTxFee(tx.GasPrice(), tx.Gas(), b.RPCTxFeeCap()); err != nil {
+	if err := checkSubmission(b, tx); err != nil {
 		return common.Hash{}, err
 	}
-	if !b.UnprotectedAllowed() && !tx.Protected() {
-		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
-	}
 	if err := b.SendTx(ctx, tx); err != nil {
 		return common.Hash{}, err
 	}
@@ -1773,6 +1937,21 @@ func SubmitTransaction(ctx context.Context, b Backend, tx *types.Transaction) (c
 	return tx.Hash(), nil
 }
 
+// checkSubmission ensures the transaction submitted over RPC adheres to the node
+// limits on fees and replay protection.
+func checkSubmission(b Backend, tx *types.Transaction) error {
+	// If the transaction fee cap is already specified, ensure the
+	// fee of the given transaction is _reasonable_.
+	if err := checkTxFee(tx.GasPrice(), tx.Gas(), b.RPCTxFeeCap()); err != nil {
+		return err
+	}
+	if !b.UnprotectedAllowed() && !tx.Protected() {
+		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
+		return errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+	}
+	return nil
+}
+
 // SendTransaction creates a transaction for the given argument, sign it and submit it to the
 // transaction pool.
 func (s *TransactionAPI) SendTransaction(ctx context.Context, args TransactionArgs) (common.Hash, error) {
@@ -1832,6 +2011,70 @@ func (s *TransactionAPI) SendRawTransaction(ctx context.Context, input hexutil.B
 	return SubmitTransaction(ctx, s.b, tx)
 }
 
+// PrivateTxArgs represents the options of a private transaction submission.
+type PrivateTxArgs struct {
+	MaxBlockNumber *hexutil.Uint64 `json:"maxBlockNumber"` // Highest block to include the transaction in
+}
+
+// SendPrivateTransaction will add the signed transaction to the transaction pool
+// without announcing it to the peers. The transaction is only included in blocks
+// sealed by this node, until the given maximum block number is reached.
+func (s *TransactionAPI) SendPrivateTransaction(ctx context.Context, input hexutil.Bytes, args *PrivateTxArgs) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	if err := checkSubmission(s.b, tx); err != nil {
+		return common.Hash{}, err
+	}
+	var maxBlock uint64
+	if args != nil && args.MaxBlockNumber != nil {
+		maxBlock = uint64(*args.MaxBlockNumber)
+	}
+	if err := s.b.SendPrivateTx(ctx, tx, maxBlock); err != nil {
+		return common.Hash{}, err
+	}
+	log.Info("Submitted private transaction", "hash", tx.Hash().Hex(), "nonce", tx.Nonce(), "recipient", tx.To(), "maxBlock", maxBlock)
+	return tx.Hash(), nil
+}
+
+// BundleArgs represents the arguments of a bundle submission.
+type BundleArgs struct {
+	Txs               []hexutil.Bytes `json:"txs"`
+	BlockNumber       *hexutil.Uint64 `json:"blockNumber"`       // Block to include the bundle in, any block if omitted
+	RevertingTxHashes []common.Hash   `json:"revertingTxHashes"` // Transactions allowed to revert
+}
+
+// SendBundle will add an ordered list of signed transactions to the transaction
+// pool, to be included contiguously in a single block sealed by this node, either
+// all of them or none. Transactions not listed in the reverting hashes must not
+// fail for the bundle to be included. It returns the hash of the bundle.
+func (s *TransactionAPI) SendBundle(ctx context.Context, args BundleArgs) (common.Hash, error) {
+	bundle := &txpool.Bundle{
+		Txs:               make(types.Transactions, len(args.Txs)),
+		RevertingTxHashes: args.RevertingTxHashes,
+	}
+	for i, input := range args.Txs {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(input); err != nil {
+			return common.Hash{}, fmt.Errorf("bundle tx %d: %w", i, err)
+		}
+		if err := checkSubmission(s.b, tx); err != nil {
+			return common.Hash{}, fmt.Errorf("bundle tx %d: %w", i, err)
+		}
+		bundle.Txs[i] = tx
+	}
+	if args.BlockNumber != nil {
+		bundle.BlockNumber = uint64(*args.BlockNumber)
+	}
+	if err := s.b.SendBundle(ctx, bundle); err != nil {
+		return common.Hash{}, err
+	}
+	hash := bundle.Hash()
+	log.Info("Submitted bundle", "hash", hash.Hex(), "txs", len(bundle.Txs), "target", bundle.BlockNumber)
+	return hash, nil
+}
+
 // Sign calculates an ECDSA signature for:
 // keccak256("\x19Ethereum Signed Message:\n" + len(message) + message).
 //
@@ -25,9 +25,18 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/mint"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/stretchr/testify/require"
 )
@@ -169,6 +178,11 @@ type backend struct {
 	getTransaction func(context.Context, common.Hash) (*types.Transaction, common.Hash, uint64, uint64, error)
 	blockByHash    func(context.Context, common.Hash) (*types.Block, error)
 	getReceipts    func(context.Context, common.Hash) (types.Receipts, error)
+
+	blockByNumberOrHash func(context.Context, rpc.BlockNumberOrHash) (*types.Block, error)
+	engine              consensus.Engine
+
+	stateAndHeader func(context.Context, rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error)
 }
 
 func (b *backend) GetTransaction(ctx context.Context, hash common.Hash) (*types.Transaction, common.Hash, uint64, uint64, error) {
@@ -187,6 +201,23 @@ func (b *backend) ChainConfig() *params.ChainConfig {
 	return b.chainConfig
 }
 
+func (b *backend) BlockByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*types.Block, error) {
+	return b.blockByNumberOrHash(ctx, blockNrOrHash)
+}
+
+func (b *backend) Engine() consensus.Engine {
+	return b.engine
+}
+
+func (b *backend) StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error) {
+	return b.stateAndHeader(ctx, blockNrOrHash)
+}
+
+func (b *backend) GetEVM(ctx context.Context, msg *core.Message, state *state.StateDB, header *types.Header, vmConfig *vm.Config) (*vm.EVM, func() error, error) {
+	blockCtx := core.NewEVMBlockContext(header, nil, &header.Coinbase)
+	return vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), state, b.chainConfig, *vmConfig), state.Error, nil
+}
+
 func TestGetBlockReceiptsFailures(t *testing.T) {
 	testCases := map[string]struct {
 		backend        *backend
@@ -416,3 +447,229 @@ func TestSuccessfulCallsOfReceiptApis(t *testing.T) {
 	require.NoError(t, err)
 	assertJsonEqual([]any{receipt1Data, receipt2Data}, allReceiptsData)
 }
+
+func TestGetBlockFees(t *testing.T) {
+	key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+
+	tx1, _ := types.SignNewTx(key, signer, &types.LegacyTx{
+		To:       &common.Address{1},
+		Nonce:    1,
+		Gas:      21000,
+		GasPrice: big.NewInt(15),
+	})
+	tx2, _ := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		To:        &common.Address{1},
+		Nonce:     2,
+		Gas:       50000,
+		GasTipCap: big.NewInt(2),
+		GasFeeCap: big.NewInt(20),
+	})
+	receipts := types.Receipts{
+		&types.Receipt{Status: types.ReceiptStatusSuccessful, GasUsed: 21000, CumulativeGasUsed: 21000},
+		&types.Receipt{Status: types.ReceiptStatusSuccessful, GasUsed: 30000, CumulativeGasUsed: 51000},
+	}
+	header := &types.Header{Number: big.NewInt(1000), Coinbase: common.Address{0xc0}, BaseFee: big.NewInt(10)}
+	block := types.NewBlock(header, []*types.Transaction{tx1, tx2}, nil, receipts, trie.NewStackTrie(nil))
+
+	newBackend := func(config *params.ChainConfig) *backend {
+		return &backend{
+			backendMock: newBackendMock(),
+			chainConfig: config,
+			engine:      ethash.NewFaker(),
+			blockByNumberOrHash: func(_ context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*types.Block, error) {
+				return block, nil
+			},
+			getReceipts: func(_ context.Context, hash common.Hash) (types.Receipts, error) {
+				require.Equal(t, block.Hash(), hash)
+				return receipts, nil
+			},
+		}
+	}
+	collector := common.Address{0xfe}
+	// Legacy tx tip is 15 - 10, dynamic fee tx tip is capped by 2
+	fees := []int64{21000 * 5, 30000 * 2}
+
+	testCases := map[string]struct {
+		config     *params.ChainConfig
+		recipients []*FeeRecipient
+	}{
+		"fees credited to coinbase": {
+			config: &params.ChainConfig{},
+			recipients: []*FeeRecipient{
+				{Address: header.Coinbase, Type: FeeRecipientCoinbase, Amount: (*hexutil.Big)(big.NewInt(fees[0] + fees[1]))},
+			},
+		},
+		"fees credited to fee collector": {
+			config: &params.ChainConfig{FeeCollectorAddress: &collector},
+			recipients: []*FeeRecipient{
+				{Address: collector, Type: FeeRecipientCollector, Amount: (*hexutil.Big)(big.NewInt(fees[0] + fees[1]))},
+			},
+		},
+		"fees split between fee collector and coinbase": {
+			config: &params.ChainConfig{
+				FeeCollectorAddress: &collector,
+				FeeSplit: &params.FeeSplitConfig{
+					Block:  big.NewInt(1000),
+					Shares: []*params.FeeShare{{Address: &collector, Percent: 70}, {Percent: 30}},
+				},
+			},
+			recipients: []*FeeRecipient{
+				{Address: collector, Type: FeeRecipientCollector, Amount: (*hexutil.Big)(big.NewInt(fees[0]*70/100 + fees[1]*70/100))},
+				{Address: header.Coinbase, Type: FeeRecipientCoinbase, Amount: (*hexutil.Big)(big.NewInt(fees[0]*30/100 + fees[1]*30/100))},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			api := NewBlockChainAPI(newBackend(testCase.config))
+
+			result, err := api.GetBlockFees(context.Background(), rpc.BlockNumberOrHashWithHash(block.Hash(), false))
+			require.NoError(t, err)
+
+			require.Equal(t, block.Hash(), result.BlockHash)
+			require.Equal(t, testCase.recipients, result.Recipients)
+			require.Equal(t, big.NewInt(fees[0]+fees[1]), result.TotalFees.ToInt())
+			require.Equal(t, big.NewInt(51000*10), result.BurntFees.ToInt())
+
+			require.Len(t, result.Transactions, 2)
+			require.Equal(t, tx2.Hash(), result.Transactions[1].TxHash)
+			require.Equal(t, hexutil.Uint64(30000), result.Transactions[1].GasUsed)
+			require.Equal(t, big.NewInt(12), result.Transactions[1].EffectiveGasPrice.ToInt())
+			require.Equal(t, big.NewInt(fees[1]), result.Transactions[1].Fee.ToInt())
+			require.Equal(t, big.NewInt(30000*10), result.Transactions[1].BurntFee.ToInt())
+		})
+	}
+
+	_, err := NewBlockChainAPI(newBackend(&params.ChainConfig{})).GetBlockFees(context.Background(), rpc.BlockNumberOrHashWithNumber(rpc.PendingBlockNumber))
+	require.Error(t, err)
+}
+
+func TestCallMany(t *testing.T) {
+	var (
+		sender    = common.Address{0x01}
+		counter   = common.Address{0x02}
+		reader    = common.Address{0x03}
+		reverter  = common.Address{0x04}
+		collector = common.Address{0xfe}
+		coinbase  = common.Address{0xc0}
+		override  = common.Address{0xc1}
+	)
+	config := *params.AllEthashProtocolChanges
+	config.FeeCollectorAddress = &collector
+
+	base := &types.Header{Number: big.NewInt(10), Time: 1000, GasLimit: 10_000_000, Difficulty: big.NewInt(1), BaseFee: big.NewInt(10), Coinbase: coinbase}
+	api := NewBlockChainAPI(&backend{
+		backendMock: newBackendMock(),
+		chainConfig: &config,
+		engine:      ethash.NewFaker(),
+		stateAndHeader: func(context.Context, rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error) {
+			statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+			return statedb, base, err
+		},
+	})
+	var (
+		// Increments slot 0, logs and returns the new value
+		counterCode = hexutil.Bytes(common.FromHex("600054600101806000558060005260206000a060206000f3"))
+		// Returns the balance of the fee collector
+		readerCode   = hexutil.Bytes(append(append([]byte{byte(vm.PUSH20)}, collector.Bytes()...), common.FromHex("3160005260206000f3")...))
+		reverterCode = hexutil.Bytes(common.FromHex("60006000fd"))
+		balance      = (*hexutil.Big)(big.NewInt(params.Ether))
+		gasPrice     = (*hexutil.Big)(big.NewInt(20))
+		time         = hexutil.Uint64(5000)
+
+		// Allows the sender to mint 1000 wei once
+		mintCode  = hexutil.Bytes(mint.Contract.Bytecode)
+		mintState = map[common.Hash]common.Hash{
+			mint.Contract.StorageLayout.Owner:     common.BytesToHash(sender.Bytes()),
+			mint.Contract.StorageLayout.MintLimit: common.BigToHash(big.NewInt(1000)),
+		}
+		mintData = hexutil.Bytes(append(common.LeftPadBytes(big.NewInt(1000).Bytes(), 32), append(common.Hash{0x01}.Bytes(), mint.BurnNetworkEthereum)...))
+	)
+	blocks := []SimulatedBlock{
+		{
+			StateOverrides: &StateOverride{
+				sender:   {Balance: &balance},
+				counter:  {Code: &counterCode},
+				reader:   {Code: &readerCode},
+				reverter: {Code: &reverterCode},
+			},
+			Calls: []TransactionArgs{
+				{From: &sender, To: &counter, GasPrice: gasPrice},
+				{From: &sender, To: &counter},
+			},
+		},
+		{
+			Calls: []TransactionArgs{
+				{From: &sender, To: &reader},
+				{From: &sender, To: &reverter},
+			},
+		},
+		{
+			BlockOverrides: &BlockOverrides{Number: (*hexutil.Big)(big.NewInt(20)), Time: &time, Coinbase: &override},
+			Calls:          []TransactionArgs{{From: &sender, To: &counter}},
+		},
+		{
+			StateOverrides: &StateOverride{
+				mint.Contract.Address: {Code: &mintCode, State: &mintState},
+			},
+			Calls: []TransactionArgs{
+				{From: &sender, To: &mint.Contract.Address, Data: &mintData, GasPrice: gasPrice},
+				{From: &sender, To: &mint.Contract.Address, Data: &mintData},
+				{From: &sender, To: &reader},
+			},
+		},
+	}
+	results, err := api.CallMany(context.Background(), blocks, nil)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	// Calls see the state changes of the previous ones
+	require.Len(t, results[0].Calls, 2)
+	require.Equal(t, hexutil.Bytes(common.LeftPadBytes([]byte{1}, 32)), results[0].Calls[0].ReturnData)
+	require.Equal(t, hexutil.Bytes(common.LeftPadBytes([]byte{2}, 32)), results[0].Calls[1].ReturnData)
+	require.Len(t, results[0].Calls[1].Logs, 1)
+	require.Equal(t, counter, results[0].Calls[1].Logs[0].Address)
+	require.Equal(t, uint(1), results[0].Calls[1].Logs[0].TxIndex)
+	require.Equal(t, results[0].Calls[0].GasUsed+results[0].Calls[1].GasUsed, results[0].GasUsed)
+	require.Equal(t, coinbase, results[0].Coinbase)
+
+	// Fees are credited to the fee collector, failures are reported per call
+	fee := big.NewInt(int64(results[0].Calls[0].GasUsed) * 10)
+	require.Equal(t, hexutil.Bytes(common.LeftPadBytes(fee.Bytes(), 32)), results[1].Calls[0].ReturnData)
+	require.Equal(t, hexutil.Uint64(types.ReceiptStatusSuccessful), results[1].Calls[0].Status)
+	require.Equal(t, hexutil.Uint64(types.ReceiptStatusFailed), results[1].Calls[1].Status)
+	require.Equal(t, "execution reverted", results[1].Calls[1].Error)
+
+	// Descendant blocks follow the previous ones unless overridden
+	require.Equal(t, hexutil.Uint64(11), results[1].Number)
+	require.Equal(t, hexutil.Uint64(1012), results[1].Time)
+	require.Equal(t, hexutil.Uint64(20), results[2].Number)
+	require.Equal(t, time, results[2].Time)
+	require.Equal(t, override, results[2].Coinbase)
+	require.Equal(t, hexutil.Bytes(common.LeftPadBytes([]byte{3}, 32)), results[2].Calls[0].ReturnData)
+
+	// Mint instructions are executed up to the mint limit
+	require.Len(t, results[3].Calls, 3)
+	require.Equal(t, hexutil.Uint64(types.ReceiptStatusSuccessful), results[3].Calls[0].Status)
+	require.Len(t, results[3].Calls[0].Logs, 1)
+	require.Equal(t, mint.Contract.Address, results[3].Calls[0].Logs[0].Address)
+	require.Equal(t, hexutil.Uint64(types.ReceiptStatusFailed), results[3].Calls[1].Status)
+	require.Equal(t, mint.ErrLimitExceeded.Error(), results[3].Calls[1].Error)
+	require.Empty(t, results[3].Calls[1].Logs)
+
+	// Mint fees are credited to the fee collector as well
+	tip := new(big.Int).Sub(gasPrice.ToInt(), results[3].BaseFee.ToInt())
+	fee.Add(fee, tip.Mul(tip, big.NewInt(int64(results[3].Calls[0].GasUsed))))
+	require.Equal(t, hexutil.Bytes(common.LeftPadBytes(fee.Bytes(), 32)), results[3].Calls[2].ReturnData)
+
+	blocks[2].BlockOverrides.Number = (*hexutil.Big)(big.NewInt(11))
+	_, err = api.CallMany(context.Background(), blocks, nil)
+	require.ErrorContains(t, err, "not above previous block")
+
+	// The total number of calls is capped
+	blocks = []SimulatedBlock{{Calls: make([]TransactionArgs, maxSimulatedCalls/2+1)}, {Calls: make([]TransactionArgs, maxSimulatedCalls/2)}}
+	_, err = api.CallMany(context.Background(), blocks, nil)
+	require.ErrorContains(t, err, "too many calls to simulate")
+}
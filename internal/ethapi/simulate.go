@@ -0,0 +1,231 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	maxSimulatedBlocks = 256  // Maximum number of blocks simulated by a single request
+	maxSimulatedCalls  = 1000 // Maximum number of calls executed by a single request
+
+	// defaultSimulatedBlockTime is the time between the simulated blocks if it
+	// is not overridden and the chain doesn't enforce a block period.
+	defaultSimulatedBlockTime = 12
+)
+
+// SimulatedBlock is a sequence of calls executed in the same block, on top of
+// the state produced by the previous simulated blocks.
+type SimulatedBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides"`
+	StateOverrides *StateOverride    `json:"stateOverrides"` // Applied before the calls of the block
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// SimulatedCallResult is the outcome of a single simulated call.
+type SimulatedCallResult struct {
+	ReturnData hexutil.Bytes  `json:"returnData"`
+	Logs       []*types.Log   `json:"logs"`
+	GasUsed    hexutil.Uint64 `json:"gasUsed"`
+	Status     hexutil.Uint64 `json:"status"`
+	Error      string         `json:"error,omitempty"`
+}
+
+// SimulatedBlockResult is the header of a simulated block along with the
+// outcome of its calls.
+type SimulatedBlockResult struct {
+	Number   hexutil.Uint64         `json:"number"`
+	Time     hexutil.Uint64         `json:"timestamp"`
+	GasLimit hexutil.Uint64         `json:"gasLimit"`
+	GasUsed  hexutil.Uint64         `json:"gasUsed"`
+	Coinbase common.Address         `json:"miner"`
+	BaseFee  *hexutil.Big           `json:"baseFeePerGas,omitempty"`
+	Calls    []*SimulatedCallResult `json:"calls"`
+}
+
+// CallMany executes the calls of the given blocks in order on top of the state
+// of the given block. Every call sees the state changes made by the previous
+// ones. The first block is simulated in the context of the given block and the
+// next ones as its descendants, unless their header fields are overridden.
+//
+// Note, this function doesn't make any changes in the state/blockchain and is
+// useful to execute and retrieve values of dependent calls.
+func (s *BlockChainAPI) CallMany(ctx context.Context, blocks []SimulatedBlock, blockNrOrHash *rpc.BlockNumberOrHash) ([]*SimulatedBlockResult, error) {
+	bNrOrHash := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	return DoCallMany(ctx, s.b, blocks, bNrOrHash, s.b.RPCEVMTimeout(), s.b.RPCGasCap())
+}
+
+// DoCallMany executes the calls of the simulated blocks sequentially on a single
+// state. The timeout applies to the whole simulation, while the gas cap applies
+// to every call, of which there are at most maxSimulatedCalls.
+func DoCallMany(ctx context.Context, b Backend, blocks []SimulatedBlock, blockNrOrHash rpc.BlockNumberOrHash, timeout time.Duration, globalGasCap uint64) ([]*SimulatedBlockResult, error) {
+	defer func(start time.Time) { log.Debug("Executing EVM calls finished", "runtime", time.Since(start)) }(time.Now())
+
+	if len(blocks) == 0 {
+		return nil, errors.New("no blocks to simulate")
+	}
+	if len(blocks) > maxSimulatedBlocks {
+		return nil, fmt.Errorf("too many blocks to simulate: %d > %d", len(blocks), maxSimulatedBlocks)
+	}
+	var calls int
+	for _, block := range blocks {
+		calls += len(block.Calls)
+	}
+	if calls > maxSimulatedCalls {
+		return nil, fmt.Errorf("too many calls to simulate: %d > %d", calls, maxSimulatedCalls)
+	}
+	state, base, err := b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	// The block author of the base block is retrieved once, since it can't be
+	// derived from the simulated headers.
+	author, err := b.Engine().Author(base)
+	if err != nil {
+		author = base.Coinbase
+	}
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	var (
+		config  = b.ChainConfig()
+		parent  *types.Header
+		results = make([]*SimulatedBlockResult, len(blocks))
+	)
+	for i, block := range blocks {
+		header := simulatedHeader(config, base, parent, author)
+		block.BlockOverrides.applyHeader(header)
+		if parent != nil && header.Number.Cmp(parent.Number) <= 0 {
+			return nil, fmt.Errorf("block %d: number %d not above previous block %d", i, header.Number, parent.Number)
+		}
+		if err := block.StateOverrides.Apply(state); err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+		var (
+			gp     = new(core.GasPool).AddGas(header.GasLimit)
+			result = &SimulatedBlockResult{
+				Number:   hexutil.Uint64(header.Number.Uint64()),
+				Time:     hexutil.Uint64(header.Time),
+				GasLimit: hexutil.Uint64(header.GasLimit),
+				Coinbase: header.Coinbase,
+				BaseFee:  (*hexutil.Big)(header.BaseFee),
+				Calls:    make([]*SimulatedCallResult, len(block.Calls)),
+			}
+		)
+		for j, args := range block.Calls {
+			// Calls without an explicit gas limit may use the gas left in the block
+			if args.Gas == nil {
+				gas := hexutil.Uint64(gp.Gas())
+				args.Gas = &gas
+			}
+			txHash := simulatedTxHash(header.Number.Uint64(), j)
+			state.SetTxContext(txHash, j)
+
+			res, err := applyCall(ctx, b, args, state, header, &header.Coinbase, gp, timeout, globalGasCap)
+			if err != nil {
+				return nil, fmt.Errorf("block %d call %d: %w", i, j, err)
+			}
+			state.Finalise(true)
+			header.GasUsed += res.UsedGas
+
+			call := &SimulatedCallResult{
+				ReturnData: res.ReturnData,
+				Logs:       state.GetLogs(txHash, header.Number.Uint64(), common.Hash{}),
+				GasUsed:    hexutil.Uint64(res.UsedGas),
+				Status:     hexutil.Uint64(types.ReceiptStatusSuccessful),
+			}
+			if call.Logs == nil {
+				call.Logs = []*types.Log{}
+			}
+			if res.Failed() {
+				call.Status = hexutil.Uint64(types.ReceiptStatusFailed)
+				if len(res.Revert()) > 0 {
+					call.Error = newRevertError(res).Error()
+				} else {
+					call.Error = res.Err.Error()
+				}
+			}
+			result.Calls[j] = call
+		}
+		result.GasUsed = hexutil.Uint64(header.GasUsed)
+		results[i] = result
+		parent = header
+	}
+	return results, nil
+}
+
+// simulatedHeader creates the header of the next simulated block. The first
+// block is simulated in the context of the base block, the next ones as the
+// descendants of the previously simulated block.
+func simulatedHeader(config *params.ChainConfig, base, parent *types.Header, author common.Address) *types.Header {
+	if parent == nil {
+		header := types.CopyHeader(base)
+		header.Coinbase = author
+		header.GasUsed = 0
+		return header
+	}
+	period := uint64(defaultSimulatedBlockTime)
+	if config.Clique != nil && config.Clique.Period > 0 {
+		period = config.Clique.Period
+	}
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Coinbase:   author,
+		Difficulty: parent.Difficulty,
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		GasLimit:   parent.GasLimit,
+		Time:       parent.Time + period,
+		MixDigest:  parent.MixDigest,
+	}
+	if config.IsLondon(header.Number) {
+		header.BaseFee = misc.CalcBaseFee(config, parent)
+	}
+	return header
+}
+
+// simulatedTxHash returns a unique hash identifying a simulated call, used to
+// collect its logs.
+func simulatedTxHash(number uint64, index int) common.Hash {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], number)
+	binary.BigEndian.PutUint64(buf[8:], uint64(index))
+	return crypto.Keccak256Hash(buf[:])
+}
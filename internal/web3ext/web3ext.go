@@ -24,6 +24,7 @@ var Modules = map[string]string{
 	"debug":    DebugJs,
 	"eth":      EthJs,
 	"miner":    MinerJs,
+	"mint":     MintJs,
 	"net":      NetJs,
 	"personal": PersonalJs,
 	"rpc":      RpcJs,
@@ -63,6 +64,11 @@ web3._extend({
 			call: 'clique_propose',
 			params: 2
 		}),
+		new web3._extend.Method({
+			name: 'proposeWithExpiry',
+			call: 'clique_propose',
+			params: 3
+		}),
 		new web3._extend.Method({
 			name: 'discard',
 			call: 'clique_discard',
@@ -73,6 +79,18 @@ web3._extend({
 			call: 'clique_status',
 			params: 0
 		}),
+		new web3._extend.Method({
+			name: 'getSignerStats',
+			call: 'clique_getSignerStats',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getProposalHistory',
+			call: 'clique_getProposalHistory',
+			params: 1,
+			inputFormatter: [null]
+		}),
 		new web3._extend.Method({
 			name: 'getSigner',
 			call: 'clique_getSigner',
@@ -436,6 +454,12 @@ web3._extend({
 			params: 3,
 			inputFormatter: [null, null, null]
 		}),
+		new web3._extend.Method({
+			name: 'traceCallMany',
+			call: 'debug_traceCallMany',
+			params: 3,
+			inputFormatter: [null, null, null]
+		}),
 		new web3._extend.Method({
 			name: 'preimage',
 			call: 'debug_preimage',
@@ -515,6 +539,17 @@ web3._extend({
 			params: 2,
 			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null]
 		}),
+		new web3._extend.Method({
+			name: 'sendPrivateTransaction',
+			call: 'eth_sendPrivateTransaction',
+			params: 2,
+			inputFormatter: [null, null]
+		}),
+		new web3._extend.Method({
+			name: 'sendBundle',
+			call: 'eth_sendBundle',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'resend',
 			call: 'eth_resend',
@@ -588,6 +623,12 @@ web3._extend({
 			params: 3,
 			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null, web3._extend.formatters.inputBlockNumberFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'getBlockFees',
+			call: 'eth_getBlockFees',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 		new web3._extend.Method({
 			name: 'createAccessList',
 			call: 'eth_createAccessList',
@@ -611,6 +652,18 @@ web3._extend({
 			params: 3,
 			inputFormatter: [web3._extend.formatters.inputCallFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter, null],
 		}),
+		new web3._extend.Method({
+			name: 'callMany',
+			call: 'eth_callMany',
+			params: 2,
+			inputFormatter: [null, web3._extend.formatters.inputDefaultBlockNumberFormatter],
+		}),
+		new web3._extend.Method({
+			name: 'getLogsPage',
+			call: 'eth_getLogsPage',
+			params: 2,
+			inputFormatter: [null, null],
+		}),
 	],
 	properties: [
 		new web3._extend.Property({
@@ -795,6 +848,42 @@ web3._extend({
 			call: 'txpool_contentFrom',
 			params: 1,
 		}),
+		new web3._extend.Method({
+			name: 'setPolicy',
+			call: 'txpool_setPolicy',
+			params: 1,
+			inputFormatter: [null]
+		}),
+		new web3._extend.Property({
+			name: 'policy',
+			getter: 'txpool_policy'
+		}),
+	]
+});
+`
+
+const MintJs = `
+web3._extend({
+	property: 'mint',
+	methods:
+	[
+		new web3._extend.Method({
+			name: 'getState',
+			call: 'mint_getState',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getMints',
+			call: 'mint_getMints',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getByBurnTx',
+			call: 'mint_getByBurnTx',
+			params: 2
+		}),
 	]
 });
 `
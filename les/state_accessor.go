@@ -53,6 +53,9 @@ func (leth *LightEthereum) stateAtTransaction(ctx context.Context, block *types.
 	if err != nil {
 		return nil, vm.BlockContext{}, nil, nil, err
 	}
+	// Apply state migrations scheduled for this block, as the state processor does it before any transaction.
+	state.InitMigrations(leth.blockchain.Config()).Execute(block.Number(), statedb, "state accessor")
+
 	if txIndex == 0 && len(block.Transactions()) == 0 {
 		return nil, vm.BlockContext{}, statedb, release, nil
 	}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -30,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
@@ -81,6 +83,8 @@ var (
 	errBlockInterruptedByNewHead  = errors.New("new head arrived while building block")
 	errBlockInterruptedByRecommit = errors.New("recommit interrupt while building block")
 	errBlockInterruptedByTimeout  = errors.New("timeout while building block")
+
+	errBundleReverted = errors.New("bundle transaction reverted")
 )
 
 // environment is the worker's current environment and holds all
@@ -99,6 +103,7 @@ type environment struct {
 	txs      []*types.Transaction
 	receipts []*types.Receipt
 	uncles   map[common.Hash]*types.Header
+	private  bool // whether private transactions or bundles are included
 }
 
 // copy creates a deep copy of environment.
@@ -112,6 +117,7 @@ func (env *environment) copy() *environment {
 		coinbase:  env.coinbase,
 		header:    types.CopyHeader(env.header),
 		receipts:  copyReceipts(env.receipts),
+		private:   env.private,
 	}
 	if env.gasPool != nil {
 		gasPool := *env.gasPool
@@ -625,7 +631,7 @@ func (w *worker) mainLoop() {
 			// Note all transactions received may not be continuous with transactions
 			// already included in the current sealing block. These transactions will
 			// be automatically eliminated.
-			if !w.isRunning() && w.current != nil {
+			if !w.isRunning() && w.current != nil && !w.current.private {
 				// If block is already full, abort
 				if gp := w.current.gasPool; gp != nil && gp.Gas() < params.TxGas {
 					continue
@@ -956,6 +962,55 @@ func (w *worker) commitTransactions(env *environment, txs *types.TransactionsByP
 	return nil
 }
 
+// commitBundle applies the transactions of the bundle contiguously on top of the
+// environment, leaving it untouched if any fails or reverts without being
+// allowed to.
+func (w *worker) commitBundle(env *environment, bundle *txpool.Bundle) error {
+	if env.gasPool == nil {
+		env.gasPool = new(core.GasPool).AddGas(env.header.GasLimit)
+	}
+	// Simulate the bundle on a copy of the environment, the state can't be
+	// reverted to a snapshot taken before the already finalised transactions.
+	sim := env.copy()
+	for _, tx := range bundle.Txs {
+		sim.state.SetTxContext(tx.Hash(), sim.tcount)
+
+		_, err := w.commitTransaction(sim, tx)
+		if err == nil && sim.receipts[len(sim.receipts)-1].Status == types.ReceiptStatusFailed && !bundle.MayRevert(tx.Hash()) {
+			err = errBundleReverted
+		}
+		if err != nil {
+			return fmt.Errorf("transaction %x: %w", tx.Hash(), err)
+		}
+		sim.tcount++
+	}
+	sim.private = true
+	*env = *sim
+	return nil
+}
+
+// commitBundles applies the bundles in order, skipping the ones which can not
+// be included in full and dropping the ones reverting from the pool.
+func (w *worker) commitBundles(env *environment, bundles []*txpool.Bundle, interrupt *atomic.Int32) error {
+	for _, bundle := range bundles {
+		if interrupt != nil {
+			if signal := interrupt.Load(); signal != commitInterruptNone {
+				return signalToErr(signal)
+			}
+		}
+		if err := w.commitBundle(env, bundle); err != nil {
+			log.Debug("Bundle skipped", "hash", bundle.Hash(), "err", err)
+
+			// Bundles reverting on a transaction not allowed to revert are dropped,
+			// the others may succeed later on, e.g. once there is enough gas left.
+			if errors.Is(err, errBundleReverted) {
+				w.eth.TxPool().RemoveBundle(bundle.Hash())
+			}
+		}
+	}
+	return nil
+}
+
 // generateParams wraps various of settings for generating sealing task.
 type generateParams struct {
 	timestamp   uint64            // The timstamp for sealing task
@@ -1056,8 +1111,9 @@ func (w *worker) prepareWork(genParams *generateParams) (*environment, error) {
 
 // fillTransactions retrieves the pending transactions from the txpool and fills them
 // into the given sealing block. The transaction selection and ordering strategy can
-// be customized with the plugin in the future.
-func (w *worker) fillTransactions(interrupt *atomic.Int32, env *environment) error {
+// be customized with the plugin in the future. Private transactions and bundles
+// are only included if private is set.
+func (w *worker) fillTransactions(interrupt *atomic.Int32, env *environment, private bool) error {
 	// Split the pending transactions into locals and remotes
 	// Fill the block with all available pending transactions.
 	pending := w.eth.TxPool().Pending(true)
@@ -1068,6 +1124,39 @@ func (w *worker) fillTransactions(interrupt *atomic.Int32, env *environment) err
 			localTxs[account] = txs
 		}
 	}
+	// Private transactions and bundles are never propagated, they are included
+	// only when sealing locally. Bundles are simulated on top of the pending state
+	// first, private transactions take precedence over the pooled ones with the
+	// same nonce.
+	privateTxs := make(map[common.Hash]struct{})
+	if private {
+		if bundles := w.eth.TxPool().Bundles(env.header.Number.Uint64()); len(bundles) > 0 {
+			if err := w.commitBundles(env, bundles, interrupt); err != nil {
+				return err
+			}
+		}
+		for account, ptxs := range w.eth.TxPool().Private(env.header.Number.Uint64()) {
+			txs, ok := localTxs[account]
+			if !ok {
+				txs = remoteTxs[account]
+				delete(remoteTxs, account)
+			}
+			localTxs[account] = mergeTxsByNonce(ptxs, txs)
+			for _, tx := range ptxs {
+				privateTxs[tx.Hash()] = struct{}{}
+			}
+		}
+	}
+	if len(privateTxs) > 0 {
+		defer func() {
+			for _, tx := range env.txs {
+				if _, ok := privateTxs[tx.Hash()]; ok {
+					env.private = true
+					break
+				}
+			}
+		}()
+	}
 	if len(localTxs) > 0 {
 		txs := types.NewTransactionsByPriceAndNonce(env.signer, localTxs, env.header.BaseFee)
 		if err := w.commitTransactions(env, txs, interrupt); err != nil {
@@ -1083,6 +1172,23 @@ func (w *worker) fillTransactions(interrupt *atomic.Int32, env *environment) err
 	return nil
 }
 
+// mergeTxsByNonce merges two nonce sorted transaction lists of the same account,
+// preferring the first list on nonce collisions.
+func mergeTxsByNonce(preferred, txs types.Transactions) types.Transactions {
+	nonces := make(map[uint64]struct{}, len(preferred))
+	for _, tx := range preferred {
+		nonces[tx.Nonce()] = struct{}{}
+	}
+	merged := append(types.Transactions{}, preferred...)
+	for _, tx := range txs {
+		if _, ok := nonces[tx.Nonce()]; !ok {
+			merged = append(merged, tx)
+		}
+	}
+	sort.Sort(types.TxByNonce(merged))
+	return merged
+}
+
 // generateWork generates a sealing block based on the given parameters.
 func (w *worker) generateWork(params *generateParams) (*types.Block, *big.Int, error) {
 	work, err := w.prepareWork(params)
@@ -1098,7 +1204,7 @@ func (w *worker) generateWork(params *generateParams) (*types.Block, *big.Int, e
 		})
 		defer timer.Stop()
 
-		err := w.fillTransactions(interrupt, work)
+		err := w.fillTransactions(interrupt, work, w.isRunning())
 		if errors.Is(err, errBlockInterruptedByTimeout) {
 			log.Warn("Block building is interrupted", "allowance", common.PrettyDuration(w.newpayloadTimeout))
 		}
@@ -1107,7 +1213,7 @@ func (w *worker) generateWork(params *generateParams) (*types.Block, *big.Int, e
 	if err != nil {
 		return nil, nil, err
 	}
-	return block, totalFees(block, work.receipts), nil
+	return block, coinbaseFees(w.chainConfig, work.coinbase, block, work.receipts), nil
 }
 
 // commitWork generates several new sealing tasks based on the parent block
@@ -1137,7 +1243,7 @@ func (w *worker) commitWork(interrupt *atomic.Int32, noempty bool, timestamp int
 		w.commit(work.copy(), nil, false, start)
 	}
 	// Fill pending transactions from the txpool into the block.
-	err = w.fillTransactions(interrupt, work)
+	err = w.fillTransactions(interrupt, work, w.isRunning())
 	switch {
 	case err == nil:
 		// The entire block is filled, decrease resubmit interval in case
@@ -1168,6 +1274,12 @@ func (w *worker) commitWork(interrupt *atomic.Int32, noempty bool, timestamp int
 	// Submit the generated block for consensus sealing.
 	w.commit(work.copy(), w.fullTaskHook, true, start)
 
+	// The pending block, state and logs must not leak the private transactions
+	// and bundles, publish the block rebuilt without them instead.
+	if work.private {
+		w.updatePublicSnapshot(interrupt, work.header)
+	}
+
 	// Swap out the old work with the new one, terminating any leftover
 	// prefetcher processes in the mean time and starting a new one.
 	if w.current != nil {
@@ -1211,12 +1323,34 @@ func (w *worker) commit(env *environment, interval func(), update bool, start ti
 			}
 		}
 	}
-	if update {
+	if update && !env.private {
 		w.updateSnapshot(env)
 	}
 	return nil
 }
 
+// updatePublicSnapshot rebuilds the sealing block on top of the same parent
+// without any private transactions or bundles, and publishes it as the pending
+// snapshot.
+func (w *worker) updatePublicSnapshot(interrupt *atomic.Int32, header *types.Header) {
+	work, err := w.prepareWork(&generateParams{
+		timestamp:  header.Time,
+		forceTime:  true,
+		parentHash: header.ParentHash,
+		coinbase:   header.Coinbase,
+	})
+	if err != nil {
+		log.Debug("Failed to prepare public pending block", "err", err)
+		return
+	}
+	defer work.discard()
+
+	if err := w.fillTransactions(interrupt, work, false); errors.Is(err, errBlockInterruptedByNewHead) {
+		return
+	}
+	w.updateSnapshot(work)
+}
+
 // getSealingBlock generates the sealing block based on the given parameters.
 // The generation result will be passed back via the given channel no matter
 // the generation itself succeeds or not.
@@ -1271,7 +1405,8 @@ func (w *worker) postSideBlock(event core.ChainSideEvent) {
 	}
 }
 
-// totalFees computes total consumed miner fees in Wei. Block transactions and receipts have to have the same order.
+// totalFees computes total consumed miner fees in Wei, including the shares credited
+// to fee collectors. Block transactions and receipts have to have the same order.
 func totalFees(block *types.Block, receipts []*types.Receipt) *big.Int {
 	feesWei := new(big.Int)
 	for i, tx := range block.Transactions() {
@@ -1281,6 +1416,27 @@ func totalFees(block *types.Block, receipts []*types.Receipt) *big.Int {
 	return feesWei
 }
 
+// coinbaseFees computes consumed miner fees credited to the coinbase in Wei. Since
+// the fee split fork, only the share credited to the coinbase is accounted. Block
+// transactions and receipts have to have the same order.
+func coinbaseFees(config *params.ChainConfig, coinbase common.Address, block *types.Block, receipts []*types.Receipt) *big.Int {
+	feesWei := new(big.Int)
+	for i, tx := range block.Transactions() {
+		minerFee, _ := tx.EffectiveGasTip(block.BaseFee())
+		fee := new(big.Int).Mul(new(big.Int).SetUint64(receipts[i].GasUsed), minerFee)
+		if !config.IsFeeSplit(block.Number()) {
+			feesWei.Add(feesWei, fee)
+			continue
+		}
+		for _, credit := range core.SplitFee(config, block.Number(), coinbase, fee) {
+			if credit.Coinbase {
+				feesWei.Add(feesWei, credit.Amount)
+			}
+		}
+	}
+	return feesWei
+}
+
 // signalToErr converts the interruption signal to a concrete error type for return.
 // The given signal must be a valid interruption signal.
 func signalToErr(signal int32) error {
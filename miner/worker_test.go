@@ -664,3 +664,149 @@ func testGetSealingWork(t *testing.T, chainConfig *params.ChainConfig, engine co
 		}
 	}
 }
+
+func TestMergeTxsByNonce(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	signer := types.HomesteadSigner{}
+	tx := func(nonce uint64, price int64) *types.Transaction {
+		tx, _ := types.SignTx(types.NewTransaction(nonce, testUserAddress, big.NewInt(1000), params.TxGas, big.NewInt(price), nil), signer, key)
+		return tx
+	}
+	private := types.Transactions{tx(1, 2), tx(3, 2)}
+	pooled := types.Transactions{tx(0, 1), tx(1, 1), tx(2, 1)}
+
+	merged := mergeTxsByNonce(private, pooled)
+	want := types.Transactions{pooled[0], private[0], pooled[2], private[1]}
+	if len(merged) != len(want) {
+		t.Fatalf("merged length mismatch: have %d, want %d", len(merged), len(want))
+	}
+	for i := range want {
+		if merged[i].Hash() != want[i].Hash() {
+			t.Errorf("transaction %d mismatch: have %x, want %x", i, merged[i].Hash(), want[i].Hash())
+		}
+	}
+}
+
+func TestCommitBundle(t *testing.T) {
+	config := *ethashChainConfig
+	config.CepheusBlock = big.NewInt(0)
+
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	w, b := newTestWorker(t, &config, engine, rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	env, err := w.prepareWork(&generateParams{timestamp: uint64(time.Now().Unix()), coinbase: testUserAddress})
+	if err != nil {
+		t.Fatalf("failed to prepare work: %v", err)
+	}
+	defer env.discard()
+
+	// The middle transaction of the bundle reverts
+	signer := types.LatestSigner(&config)
+	gasPrice := big.NewInt(10 * params.InitialBaseFee)
+	txs := types.Transactions{
+		types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{Nonce: 0, To: &testUserAddress, Value: big.NewInt(1000), Gas: params.TxGas, GasPrice: gasPrice}),
+		types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{Nonce: 1, Value: big.NewInt(0), Gas: 100000, GasPrice: gasPrice, Data: common.FromHex("0x60006000fd")}),
+		types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{Nonce: 2, To: &testUserAddress, Value: big.NewInt(1000), Gas: params.TxGas, GasPrice: gasPrice}),
+	}
+	if err := w.commitBundle(env, &txpool.Bundle{Txs: txs}); !errors.Is(err, errBundleReverted) {
+		t.Fatalf("reverting bundle error mismatch: have %v, want %v", err, errBundleReverted)
+	}
+	if len(env.txs) != 0 || len(env.receipts) != 0 || env.tcount != 0 {
+		t.Errorf("reverting bundle included: %d txs, %d receipts, tcount %d", len(env.txs), len(env.receipts), env.tcount)
+	}
+	if nonce := env.state.GetNonce(testBankAddress); nonce != 0 {
+		t.Errorf("reverting bundle state applied: nonce %d", nonce)
+	}
+	if env.gasPool.Gas() != env.header.GasLimit || env.header.GasUsed != 0 {
+		t.Errorf("reverting bundle gas used: pool %d, header %d", env.gasPool.Gas(), env.header.GasUsed)
+	}
+	// Bundles allowing the transaction to revert are included in full
+	if err := w.commitBundle(env, &txpool.Bundle{Txs: txs, RevertingTxHashes: []common.Hash{txs[1].Hash()}}); err != nil {
+		t.Fatalf("failed to commit bundle: %v", err)
+	}
+	if len(env.txs) != 3 || env.tcount != 3 {
+		t.Errorf("bundle not included: %d txs, tcount %d", len(env.txs), env.tcount)
+	}
+	if nonce := env.state.GetNonce(testBankAddress); nonce != 3 {
+		t.Errorf("bundle state not applied: nonce %d", nonce)
+	}
+	if env.receipts[1].Status != types.ReceiptStatusFailed {
+		t.Errorf("middle transaction didn't revert")
+	}
+	// Reverting bundles are dropped from the pool
+	if err := b.txPool.AddBundle(&txpool.Bundle{Txs: txs}); err != nil {
+		t.Fatalf("failed to add bundle: %v", err)
+	}
+	sim, err := w.prepareWork(&generateParams{timestamp: uint64(time.Now().Unix()), coinbase: testUserAddress})
+	if err != nil {
+		t.Fatalf("failed to prepare work: %v", err)
+	}
+	defer sim.discard()
+
+	if err := w.commitBundles(sim, b.txPool.Bundles(1), nil); err != nil {
+		t.Fatalf("failed to commit bundles: %v", err)
+	}
+	if bundles := b.txPool.Bundles(1); len(bundles) != 0 {
+		t.Errorf("reverting bundle not dropped: %d bundles left", len(bundles))
+	}
+}
+
+func TestPendingExcludesPrivate(t *testing.T) {
+	config := *ethashChainConfig
+	config.CepheusBlock = big.NewInt(0)
+
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	w, b := newTestWorker(t, &config, engine, rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	tasks := make(chan *task, 1)
+	w.newTaskHook = func(task *task) {
+		select {
+		case tasks <- task:
+		default:
+		}
+	}
+	w.skipSealHook = func(task *task) bool { return true }
+
+	private := types.MustSignNewTx(testBankKey, types.LatestSigner(&config), &types.LegacyTx{
+		Nonce:    1,
+		To:       &testUserAddress,
+		Value:    big.NewInt(2000),
+		Gas:      params.TxGas,
+		GasPrice: big.NewInt(10 * params.InitialBaseFee),
+	})
+	if err := b.txPool.AddPrivate(private, 0); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+	w.running.Store(true)
+	w.commitWork(nil, true, time.Now().Unix())
+
+	// The sealed block includes the private transaction
+	select {
+	case task := <-tasks:
+		if txs := task.block.Transactions(); len(txs) != 2 || txs[1].Hash() != private.Hash() {
+			t.Fatalf("private transaction not sealed: %d txs", len(txs))
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("sealing task timeout")
+	}
+	// The pending block and state are built from the public transactions only
+	block, state := w.pending()
+	if block == nil {
+		t.Fatal("no pending block")
+	}
+	if txs := block.Transactions(); len(txs) != 1 || txs[0].Hash() != pendingTxs[0].Hash() {
+		t.Errorf("pending block transactions mismatch: have %d txs", len(txs))
+	}
+	if nonce := state.GetNonce(testBankAddress); nonce != 1 {
+		t.Errorf("pending state nonce mismatch: have %d, want 1", nonce)
+	}
+	if _, receipts := w.pendingBlockAndReceipts(); len(receipts) != 1 {
+		t.Errorf("pending receipts mismatch: have %d, want 1", len(receipts))
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/time/rate"
+)
+
+const (
+	errcodeAPIKeyDenied  = -32601 // Same as a missing method, the namespace isn't available to the key
+	errcodeAPIKeyLimited = -32005
+)
+
+// APIKey is an entry of the API keys file.
+type APIKey struct {
+	Name       string   `json:"name"`                 // Name of the key in logs and metrics
+	Key        string   `json:"key"`                  // Secret sent by the clients
+	Namespaces []string `json:"namespaces,omitempty"` // Namespaces the key can call, all if empty
+	Rate       float64  `json:"rate,omitempty"`       // Calls per second, unlimited if zero
+	Burst      int      `json:"burst,omitempty"`      // Calls allowed at once, at least one
+}
+
+// apiKey is a loaded API key along with its rate limiter and usage meters.
+type apiKey struct {
+	name       string
+	namespaces map[string]bool
+	limiter    *rate.Limiter
+
+	requests metrics.Meter // Calls made with the key
+	limited  metrics.Meter // Calls rejected due to the rate limit
+	denied   metrics.Meter // Calls rejected due to the namespace
+}
+
+// apiKeys are the API keys accepted by the HTTP and WS servers, indexed by the
+// hash of their secret. A nil set doesn't require any key.
+type apiKeys struct {
+	keys map[[32]byte]*apiKey
+}
+
+// apiKeyError is returned to the callers rejected by their API key.
+type apiKeyError struct {
+	code int
+	msg  string
+}
+
+func (e *apiKeyError) Error() string  { return e.msg }
+func (e *apiKeyError) ErrorCode() int { return e.code }
+
+// loadAPIKeys reads the API keys from the given JSON file.
+func loadAPIKeys(file string) (*apiKeys, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var entries []APIKey
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("invalid API keys file %s: %w", file, err)
+	}
+	keys, err := newAPIKeys(entries)
+	if err != nil {
+		return nil, fmt.Errorf("invalid API keys file %s: %w", file, err)
+	}
+	log.Info("Loaded API keys", "path", file, "keys", len(entries))
+	return keys, nil
+}
+
+// newAPIKeys creates the API key set of the given entries.
+func newAPIKeys(entries []APIKey) (*apiKeys, error) {
+	if len(entries) == 0 {
+		return nil, errors.New("no API keys")
+	}
+	var (
+		keys  = &apiKeys{keys: make(map[[32]byte]*apiKey, len(entries))}
+		names = make(map[string]bool, len(entries))
+	)
+	for i, entry := range entries {
+		switch {
+		case entry.Name == "":
+			return nil, fmt.Errorf("key %d: missing name", i)
+		case entry.Key == "":
+			return nil, fmt.Errorf("key %s: missing secret", entry.Name)
+		case names[entry.Name]:
+			return nil, fmt.Errorf("key %s: duplicate name", entry.Name)
+		case entry.Rate < 0 || entry.Burst < 0:
+			return nil, fmt.Errorf("key %s: negative rate limit", entry.Name)
+		}
+		hash := sha256.Sum256([]byte(entry.Key))
+		if _, ok := keys.keys[hash]; ok {
+			return nil, fmt.Errorf("key %s: duplicate secret", entry.Name)
+		}
+		names[entry.Name] = true
+
+		limit, burst := rate.Inf, entry.Burst
+		if entry.Rate > 0 {
+			limit = rate.Limit(entry.Rate)
+			if burst == 0 {
+				burst = int(math.Max(1, math.Ceil(entry.Rate)))
+			}
+		}
+		key := &apiKey{
+			name:     entry.Name,
+			limiter:  rate.NewLimiter(limit, burst),
+			requests: metrics.GetOrRegisterMeter("rpc/apikey/"+entry.Name+"/requests", nil),
+			limited:  metrics.GetOrRegisterMeter("rpc/apikey/"+entry.Name+"/limited", nil),
+			denied:   metrics.GetOrRegisterMeter("rpc/apikey/"+entry.Name+"/denied", nil),
+		}
+		if len(entry.Namespaces) > 0 {
+			key.namespaces = make(map[string]bool, len(entry.Namespaces))
+			for _, namespace := range entry.Namespaces {
+				key.namespaces[namespace] = true
+			}
+		}
+		keys.keys[hash] = key
+	}
+	return keys, nil
+}
+
+// lookup returns the API key with the given secret, or nil if it's unknown.
+func (keys *apiKeys) lookup(secret string) *apiKey {
+	if secret == "" {
+		return nil
+	}
+	return keys.keys[sha256.Sum256([]byte(secret))]
+}
+
+// protect wraps the handler so that it rejects requests without a known API key.
+// Calls made with a known key are checked by filter.
+func (keys *apiKeys) protect(next http.Handler) http.Handler {
+	if keys == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secret := rpc.APIKeyFromRequest(r)
+		switch {
+		case secret == "":
+			http.Error(w, "missing API key", http.StatusUnauthorized)
+		case keys.lookup(secret) == nil:
+			http.Error(w, "invalid API key", http.StatusUnauthorized)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// filter checks a method call against the namespaces and the rate limit of the
+// API key of the caller.
+func (keys *apiKeys) filter(ctx context.Context, method string) error {
+	key := keys.lookup(rpc.PeerInfoFromContext(ctx).HTTP.APIKey)
+	if key == nil {
+		// Only reachable if the server is used without the protecting handler
+		return &apiKeyError{errcodeAPIKeyDenied, "invalid API key"}
+	}
+	key.requests.Mark(1)
+
+	namespace, _, _ := strings.Cut(method, "_")
+	if key.namespaces != nil && !key.namespaces[namespace] {
+		key.denied.Mark(1)
+		return &apiKeyError{errcodeAPIKeyDenied, fmt.Sprintf("the method %s is not available with this API key", method)}
+	}
+	if !key.limiter.Allow() {
+		key.limited.Mark(1)
+		return &apiKeyError{errcodeAPIKeyLimited, "API key rate limit exceeded"}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeys(t *testing.T) {
+	keys, err := newAPIKeys([]APIKey{
+		{Name: "full", Key: "full-secret"},
+		{Name: "test", Key: "test-secret", Namespaces: []string{"test"}},
+		{Name: "slow", Key: "slow-secret", Rate: 0.001},
+	})
+	require.NoError(t, err)
+
+	srv := createAndStartServer(t, &httpConfig{apiKeys: keys}, true, &wsConfig{Origins: []string{"*"}, apiKeys: keys}, nil)
+	defer srv.stop()
+	wsURL := fmt.Sprintf("ws://%v", srv.listenAddr())
+	httpURL := fmt.Sprintf("http://%v", srv.listenAddr())
+
+	call := func(url, method string, headers ...string) (int, string) {
+		resp := rpcRequest(t, url, method, headers...)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(body)
+	}
+	// Requests without a known key are rejected
+	status, _ := call(httpURL, testMethod)
+	assert.Equal(t, http.StatusUnauthorized, status)
+	status, _ = call(httpURL, testMethod, "X-API-Key", "wrong")
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.Error(t, wsRequest(t, wsURL))
+
+	// Keys are accepted in the header or the query
+	status, body := call(httpURL, testMethod, "X-API-Key", "full-secret")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, `"result"`)
+	status, body = call(httpURL+"?apikey=test-secret", "test_greet")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, `"Hello"`)
+	assert.NoError(t, wsRequest(t, wsURL, "X-API-Key", "full-secret"))
+
+	// Calls outside the namespaces of the key are denied
+	_, body = call(httpURL, testMethod, "X-API-Key", "test-secret")
+	assert.Contains(t, body, fmt.Sprint(errcodeAPIKeyDenied))
+
+	client, err := rpc.DialWebsocket(context.Background(), wsURL+"?apikey=test-secret", "")
+	require.NoError(t, err)
+	defer client.Close()
+	var rpcErr rpc.Error
+	require.True(t, errors.As(client.Call(nil, testMethod), &rpcErr))
+	assert.Equal(t, errcodeAPIKeyDenied, rpcErr.ErrorCode())
+
+	// Calls beyond the rate limit of the key are rejected
+	_, body = call(httpURL, testMethod, "X-API-Key", "slow-secret")
+	assert.Contains(t, body, `"result"`)
+	_, body = call(httpURL, testMethod, "X-API-Key", "slow-secret")
+	assert.Contains(t, body, fmt.Sprint(errcodeAPIKeyLimited))
+}
+
+func TestLoadAPIKeys(t *testing.T) {
+	tests := []struct {
+		file string
+		err  bool
+	}{
+		{`[{"name":"a","key":"x","namespaces":["eth"],"rate":5,"burst":10}]`, false},
+		{`[]`, true},
+		{`{"name":"a"}`, true},
+		{`[{"key":"x"}]`, true},
+		{`[{"name":"a"}]`, true},
+		{`[{"name":"a","key":"x"},{"name":"a","key":"y"}]`, true},
+		{`[{"name":"a","key":"x"},{"name":"b","key":"x"}]`, true},
+		{`[{"name":"a","key":"x","rate":-1}]`, true},
+	}
+	for i, tt := range tests {
+		path := filepath.Join(t.TempDir(), "apikeys.json")
+		require.NoError(t, os.WriteFile(path, []byte(tt.file), 0600))
+		_, err := loadAPIKeys(path)
+		if tt.err {
+			assert.Error(t, err, "test %d", i)
+		} else {
+			assert.NoError(t, err, "test %d", i)
+		}
+	}
+}
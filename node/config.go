@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -101,6 +102,9 @@ type Config struct {
 	// relative), then that specific path is enforced. An empty path disables IPC.
 	IPCPath string
 
+	// IPCLimits are the request limits of the IPC RPC interface.
+	IPCLimits rpc.Limits
+
 	// HTTPHost is the host interface on which to start the HTTP RPC server. If this
 	// field is empty, no HTTP API endpoint will be started.
 	HTTPHost string
@@ -136,6 +140,9 @@ type Config struct {
 	// HTTPPathPrefix specifies a path prefix on which http-rpc is to be served.
 	HTTPPathPrefix string `toml:",omitempty"`
 
+	// HTTPLimits are the request limits of the HTTP RPC interface.
+	HTTPLimits rpc.Limits
+
 	// AuthAddr is the listening address on which authenticated APIs are provided.
 	AuthAddr string `toml:",omitempty"`
 
@@ -168,6 +175,9 @@ type Config struct {
 	// exposed.
 	WSModules []string
 
+	// WSLimits are the request limits of the websocket RPC interface.
+	WSLimits rpc.Limits
+
 	// WSExposeAll exposes all API modules via the WebSocket RPC interface rather
 	// than just the public ones.
 	//
@@ -200,6 +210,19 @@ type Config struct {
 	// JWTSecret is the path to the hex-encoded jwt secret.
 	JWTSecret string `toml:",omitempty"`
 
+	// APIKeysFile is the path to the JSON file of API keys required by the HTTP
+	// and WS RPC interfaces. If empty, they accept requests without a key.
+	APIKeysFile string `toml:",omitempty"`
+
+	// RPCAccessLog is the path of the JSON lines log of the calls served by the
+	// IPC, HTTP and WS RPC interfaces. If empty, no access log is written.
+	RPCAccessLog string `toml:",omitempty"`
+
+	// RPCSlowLog is the path of the JSON lines log of the calls taking longer
+	// than RPCSlowThreshold, including their parameters.
+	RPCSlowLog       string        `toml:",omitempty"`
+	RPCSlowThreshold time.Duration `toml:",omitempty"`
+
 	// EnablePersonal enables the deprecated personal namespace.
 	EnablePersonal bool `toml:"-"`
 
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"hash/crc32"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -64,6 +65,9 @@ type Node struct {
 	ipc           *ipcServer  // Stores information about the ipc http server
 	inprocHandler *rpc.Server // In-process RPC request handler to process the API requests
 
+	rpcAccessLog *rpc.AccessLog // Access and slow query logs of the RPC servers, nil if disabled
+	rpcLogFiles  []*os.File     // Open files of the access and slow query logs
+
 	databases map[*closeTrackingDB]struct{} // All open databases
 }
 
@@ -151,7 +155,7 @@ func New(conf *Config) (*Node, error) {
 	node.httpAuth = newHTTPServer(node.log, conf.HTTPTimeouts)
 	node.ws = newHTTPServer(node.log, rpc.DefaultHTTPTimeouts)
 	node.wsAuth = newHTTPServer(node.log, rpc.DefaultHTTPTimeouts)
-	node.ipc = newIPCServer(node.log, conf.IPCEndpoint())
+	node.ipc = newIPCServer(node.log, conf.IPCEndpoint(), conf.IPCLimits)
 
 	return node, nil
 }
@@ -391,9 +395,15 @@ func (n *Node) startRPC() error {
 	if err := n.startInProc(apis); err != nil {
 		return err
 	}
+	accessLog, err := n.openAccessLog()
+	if err != nil {
+		return err
+	}
+	n.rpcAccessLog = accessLog
 
 	// Configure IPC.
 	if n.ipc.endpoint != "" {
+		n.ipc.accessLog = accessLog
 		if err := n.ipc.start(apis); err != nil {
 			return err
 		}
@@ -401,7 +411,13 @@ func (n *Node) startRPC() error {
 	var (
 		servers           []*httpServer
 		openAPIs, allAPIs = n.getAPIs()
+		keys              *apiKeys
 	)
+	if n.config.APIKeysFile != "" {
+		if keys, err = loadAPIKeys(n.config.APIKeysFile); err != nil {
+			return err
+		}
+	}
 
 	initHttp := func(server *httpServer, port int) error {
 		if err := server.setListenAddr(n.config.HTTPHost, port); err != nil {
@@ -412,6 +428,9 @@ func (n *Node) startRPC() error {
 			Vhosts:             n.config.HTTPVirtualHosts,
 			Modules:            n.config.HTTPModules,
 			prefix:             n.config.HTTPPathPrefix,
+			limits:             n.config.HTTPLimits,
+			apiKeys:            keys,
+			accessLog:          accessLog,
 		}); err != nil {
 			return err
 		}
@@ -425,9 +444,12 @@ func (n *Node) startRPC() error {
 			return err
 		}
 		if err := server.enableWS(openAPIs, wsConfig{
-			Modules: n.config.WSModules,
-			Origins: n.config.WSOrigins,
-			prefix:  n.config.WSPathPrefix,
+			Modules:   n.config.WSModules,
+			Origins:   n.config.WSOrigins,
+			prefix:    n.config.WSPathPrefix,
+			limits:    n.config.WSLimits,
+			apiKeys:   keys,
+			accessLog: accessLog,
 		}); err != nil {
 			return err
 		}
@@ -519,6 +541,50 @@ func (n *Node) stopRPC() {
 	n.wsAuth.stop()
 	n.ipc.stop()
 	n.stopInProc()
+
+	// Write out the buffered log entries before closing the files
+	if n.rpcAccessLog != nil {
+		n.rpcAccessLog.Close()
+		n.rpcAccessLog = nil
+	}
+	for _, file := range n.rpcLogFiles {
+		file.Close()
+	}
+	n.rpcLogFiles = nil
+}
+
+// openAccessLog opens the configured access and slow query logs of the RPC
+// servers, returning nil if neither is enabled.
+func (n *Node) openAccessLog() (*rpc.AccessLog, error) {
+	open := func(path string) (*os.File, error) {
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+		if err != nil {
+			return nil, err
+		}
+		n.rpcLogFiles = append(n.rpcLogFiles, file)
+		return file, nil
+	}
+	var access, slow io.Writer
+	if n.config.RPCAccessLog != "" {
+		file, err := open(n.config.RPCAccessLog)
+		if err != nil {
+			return nil, err
+		}
+		access = file
+		n.log.Info("Writing RPC access log", "path", n.config.RPCAccessLog)
+	}
+	if n.config.RPCSlowLog != "" {
+		file, err := open(n.config.RPCSlowLog)
+		if err != nil {
+			return nil, err
+		}
+		slow = file
+		n.log.Info("Writing RPC slow query log", "path", n.config.RPCSlowLog, "threshold", n.config.RPCSlowThreshold)
+	}
+	if access == nil && slow == nil {
+		return nil, nil
+	}
+	return rpc.NewAccessLog(access, slow, n.config.RPCSlowThreshold), nil
 }
 
 // startInProc registers all RPC APIs on the inproc server.
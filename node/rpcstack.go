@@ -40,16 +40,22 @@ type httpConfig struct {
 	Modules            []string
 	CorsAllowedOrigins []string
 	Vhosts             []string
-	prefix             string // path prefix on which to mount http handler
-	jwtSecret          []byte // optional JWT secret
+	prefix             string         // path prefix on which to mount http handler
+	jwtSecret          []byte         // optional JWT secret
+	limits             rpc.Limits     // request limits of the server
+	apiKeys            *apiKeys       // optional API keys required by the server
+	accessLog          *rpc.AccessLog // optional log of the calls served
 }
 
 // wsConfig is the JSON-RPC/Websocket configuration
 type wsConfig struct {
 	Origins   []string
 	Modules   []string
-	prefix    string // path prefix on which to mount ws handler
-	jwtSecret []byte // optional JWT secret
+	prefix    string         // path prefix on which to mount ws handler
+	jwtSecret []byte         // optional JWT secret
+	limits    rpc.Limits     // request limits of the server
+	apiKeys   *apiKeys       // optional API keys required by the server
+	accessLog *rpc.AccessLog // optional log of the calls served
 }
 
 type rpcHandler struct {
@@ -297,12 +303,17 @@ func (h *httpServer) enableRPC(apis []rpc.API, config httpConfig) error {
 
 	// Create RPC server and handler.
 	srv := rpc.NewServer()
+	srv.SetLimits(config.limits)
+	srv.SetAccessLog(config.accessLog)
+	if config.apiKeys != nil {
+		srv.SetCallFilter(config.apiKeys.filter)
+	}
 	if err := RegisterApis(apis, config.Modules, srv); err != nil {
 		return err
 	}
 	h.httpConfig = config
 	h.httpHandler.Store(&rpcHandler{
-		Handler: NewHTTPHandlerStack(srv, config.CorsAllowedOrigins, config.Vhosts, config.jwtSecret),
+		Handler: NewHTTPHandlerStack(config.apiKeys.protect(srv), config.CorsAllowedOrigins, config.Vhosts, config.jwtSecret),
 		server:  srv,
 	})
 	return nil
@@ -328,12 +339,17 @@ func (h *httpServer) enableWS(apis []rpc.API, config wsConfig) error {
 	}
 	// Create RPC server and handler.
 	srv := rpc.NewServer()
+	srv.SetLimits(config.limits)
+	srv.SetAccessLog(config.accessLog)
+	if config.apiKeys != nil {
+		srv.SetCallFilter(config.apiKeys.filter)
+	}
 	if err := RegisterApis(apis, config.Modules, srv); err != nil {
 		return err
 	}
 	h.wsConfig = config
 	h.wsHandler.Store(&rpcHandler{
-		Handler: NewWSHandlerStack(srv.WebsocketHandler(config.Origins), config.jwtSecret),
+		Handler: NewWSHandlerStack(config.apiKeys.protect(srv.WebsocketHandler(config.Origins)), config.jwtSecret),
 		server:  srv,
 	})
 	return nil
@@ -569,16 +585,18 @@ func newGzipHandler(next http.Handler) http.Handler {
 }
 
 type ipcServer struct {
-	log      log.Logger
-	endpoint string
+	log       log.Logger
+	endpoint  string
+	limits    rpc.Limits
+	accessLog *rpc.AccessLog // set before start
 
 	mu       sync.Mutex
 	listener net.Listener
 	srv      *rpc.Server
 }
 
-func newIPCServer(log log.Logger, endpoint string) *ipcServer {
-	return &ipcServer{log: log, endpoint: endpoint}
+func newIPCServer(log log.Logger, endpoint string, limits rpc.Limits) *ipcServer {
+	return &ipcServer{log: log, endpoint: endpoint, limits: limits}
 }
 
 // Start starts the httpServer's http.Server
@@ -589,7 +607,7 @@ func (is *ipcServer) start(apis []rpc.API) error {
 	if is.listener != nil {
 		return nil // already running
 	}
-	listener, srv, err := rpc.StartIPCEndpoint(is.endpoint, apis)
+	listener, srv, err := rpc.StartIPCEndpoint(is.endpoint, apis, is.limits, is.accessLog)
 	if err != nil {
 		is.log.Warn("IPC opening failed", "url", is.endpoint, "error", err)
 		return err
@@ -17,10 +17,14 @@
 package params
 
 import (
+	"bytes"
 	"encoding/binary"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -516,6 +520,8 @@ type ChainConfig struct {
 
 	MintContract *MintContractConfig `json:"mintContract,omitempty"` // Mint contract initialization config
 
+	StateMigrations []*StateMigrationConfig `json:"stateMigrations,omitempty"` // Irregular state changes scheduled by the network
+
 	// TerminalTotalDifficulty is the amount of total difficulty reached by
 	// the network that triggers the consensus upgrade.
 	TerminalTotalDifficulty *big.Int `json:"terminalTotalDifficulty,omitempty"`
@@ -530,19 +536,98 @@ type ChainConfig struct {
 	Clique *CliqueConfig `json:"clique,omitempty"`
 
 	FeeCollectorAddress *common.Address `json:"feeCollectorAddress,omitempty"`
+	FeeSplit            *FeeSplitConfig `json:"feeSplit,omitempty"` // Fee distribution between multiple recipients (nil = no fork)
 
 	SystemContracts *SystemContracts `json:"systemContracts,omitempty"`
 }
 
+// MintContractConfig is the mint contract initialization config.
+// The unique burn tx fork only tracks burn txs minted since the fork block, so burn txs
+// minted before it have to be marked as used by a state migration declared at the fork
+// block, which can be generated from the mint index with `geth migrations burntxs`.
 type MintContractConfig struct {
-	ActivationBlock *big.Int              `json:"activationBlock"`
-	OwnerAddress    common.Address        `json:"ownerAddress"`
-	MintLimit       *math.HexOrDecimal256 `json:"mintLimit"`
+	ActivationBlock   *big.Int              `json:"activationBlock"`
+	OwnerAddress      common.Address        `json:"ownerAddress"`
+	MintLimit         *math.HexOrDecimal256 `json:"mintLimit"`
+	UniqueBurnTxBlock *big.Int              `json:"uniqueBurnTxBlock,omitempty"` // Burn tx reuse rejection switch block (nil = no fork)
+}
+
+// Names of the state migrations built into the node, the ones declared in the
+// config must not reuse them.
+const (
+	MintContractMigrationName = "mint contract initialization"
+	CassiopeiaMigrationName   = "cassiopeia"
+)
+
+// StateMigrationConfig declares an irregular state change, which is applied
+// at the beginning of the specified block before any transaction.
+type StateMigrationConfig struct {
+	Name     string                                    `json:"name"`
+	Block    *big.Int                                  `json:"block"`
+	Accounts map[common.Address]*StateMigrationAccount `json:"accounts"`
+}
+
+// StateMigrationAccount lists state changes applied to a single account.
+// Fields which are not specified are left intact.
+type StateMigrationAccount struct {
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+	Balance *math.HexOrDecimal256       `json:"balance,omitempty"`
+	Nonce   *math.HexOrDecimal64        `json:"nonce,omitempty"`
+}
+
+// FeeSplitConfig distributes transaction fees between multiple recipients since
+// the activation block, replacing a single fee collector or coinbase recipient.
+type FeeSplitConfig struct {
+	Block  *big.Int    `json:"block"`
+	Shares []*FeeShare `json:"shares"`
+}
+
+// FeeShare is a percentage of transaction fees credited to a single recipient.
+// If address is not set, the share is credited to the block coinbase (signer).
+type FeeShare struct {
+	Address *common.Address `json:"address,omitempty"`
+	Percent uint64          `json:"percent"`
+}
+
+// sameShares checks whether both fee splits distribute fees between the same recipients.
+func (c *FeeSplitConfig) sameShares(other *FeeSplitConfig) bool {
+	if other == nil || len(c.Shares) != len(other.Shares) {
+		return false
+	}
+	for i, share := range c.Shares {
+		if share.Percent != other.Shares[i].Percent {
+			return false
+		}
+		if (share.Address == nil) != (other.Shares[i].Address == nil) || (share.Address != nil && *share.Address != *other.Shares[i].Address) {
+			return false
+		}
+	}
+	return true
+}
+
+// validate checks that the fee split is scheduled and its shares sum up to 100 percent.
+func (c *FeeSplitConfig) validate() error {
+	if c.Block == nil {
+		return errors.New("fee split block is not set")
+	}
+	var total uint64
+	for i, share := range c.Shares {
+		if share.Percent == 0 {
+			return fmt.Errorf("fee split share %d is empty", i)
+		}
+		total += share.Percent
+	}
+	if total != 100 {
+		return fmt.Errorf("fee split shares sum up to %d percent, want 100", total)
+	}
+	return nil
 }
 
 type SystemContracts struct {
-	SoulDrop   common.Address `json:"soulDrop"`
-	HoldAmount common.Address `json:"holdAmount"`
+	SoulDrop          common.Address `json:"soulDrop"`
+	HoldAmount        common.Address `json:"holdAmount"`
+	ValidatorRegistry common.Address `json:"validatorRegistry,omitempty"`
 }
 
 // EthashConfig is the consensus engine configs for proof-of-work based sealing.
@@ -557,6 +642,21 @@ func (c *EthashConfig) String() string {
 type CliqueConfig struct {
 	Period uint64 `json:"period"` // Number of seconds between blocks to enforce
 	Epoch  uint64 `json:"epoch"`  // Epoch length to reset votes and checkpoint
+
+	SuspensionBlock     *big.Int `json:"suspensionBlock,omitempty"`     // Offline signers suspension switch block (nil = no fork)
+	SuspensionThreshold uint64   `json:"suspensionThreshold,omitempty"` // Number of consecutive missed in-turn slots to suspend a signer
+
+	RegistryBlock *big.Int `json:"registryBlock,omitempty"` // Validator registry switch block (nil = no fork)
+}
+
+// IsSuspension returns whether num is either equal to the offline signers suspension fork block or greater.
+func (c *CliqueConfig) IsSuspension(num uint64) bool {
+	return c.SuspensionBlock != nil && c.SuspensionBlock.Uint64() <= num
+}
+
+// IsRegistry returns whether num is either equal to the validator registry fork block or greater.
+func (c *CliqueConfig) IsRegistry(num uint64) bool {
+	return c.RegistryBlock != nil && c.RegistryBlock.Uint64() <= num
 }
 
 // String implements the stringer interface, returning the consensus engine details.
@@ -625,6 +725,21 @@ func (c *ChainConfig) Description() string {
 	if c.CepheusBlock != nil {
 		banner += fmt.Sprintf(" - Cepheus:                     #%-8v\n", c.CepheusBlock)
 	}
+	if c.uniqueBurnTxBlock() != nil {
+		banner += fmt.Sprintf(" - Unique burn tx:              #%-8v\n", c.uniqueBurnTxBlock())
+	}
+	if c.feeSplitBlock() != nil {
+		banner += fmt.Sprintf(" - Fee split:                   #%-8v\n", c.feeSplitBlock())
+	}
+	if c.cliqueSuspensionBlock() != nil {
+		banner += fmt.Sprintf(" - Clique signer suspension:    #%-8v\n", c.cliqueSuspensionBlock())
+	}
+	if c.cliqueRegistryBlock() != nil {
+		banner += fmt.Sprintf(" - Clique validator registry:   #%-8v\n", c.cliqueRegistryBlock())
+	}
+	for _, migration := range c.StateMigrations {
+		banner += fmt.Sprintf(" - State migration %-13v #%-8v\n", migration.Name+":", migration.Block)
+	}
 	banner += "\n"
 
 	// Add a special section for the merge as it's non-obvious
@@ -756,6 +871,63 @@ func (c *ChainConfig) IsCepheus(num *big.Int) bool {
 	return isBlockForked(c.CepheusBlock, num)
 }
 
+// IsUniqueBurnTx returns whether num is either equal to the burn tx uniqueness fork block or greater.
+// Starting from this block, a mint instruction referring to an already minted burn tx is rejected.
+func (c *ChainConfig) IsUniqueBurnTx(num *big.Int) bool {
+	return isBlockForked(c.uniqueBurnTxBlock(), num)
+}
+
+func (c *ChainConfig) uniqueBurnTxBlock() *big.Int {
+	if c.MintContract == nil {
+		return nil
+	}
+	return c.MintContract.UniqueBurnTxBlock
+}
+
+// IsFeeSplit returns whether num is either equal to the fee split fork block or greater.
+func (c *ChainConfig) IsFeeSplit(num *big.Int) bool {
+	return isBlockForked(c.feeSplitBlock(), num)
+}
+
+func (c *ChainConfig) feeSplitBlock() *big.Int {
+	if c.FeeSplit == nil {
+		return nil
+	}
+	return c.FeeSplit.Block
+}
+
+func (c *ChainConfig) cliqueSuspensionBlock() *big.Int {
+	if c.Clique == nil {
+		return nil
+	}
+	return c.Clique.SuspensionBlock
+}
+
+func (c *ChainConfig) cliqueRegistryBlock() *big.Int {
+	if c.Clique == nil {
+		return nil
+	}
+	return c.Clique.RegistryBlock
+}
+
+// stateMigrationBlocks returns activation blocks of declared state migrations by their names.
+func (c *ChainConfig) stateMigrationBlocks() map[string]*big.Int {
+	blocks := make(map[string]*big.Int, len(c.StateMigrations))
+	for _, migration := range c.StateMigrations {
+		blocks[migration.Name] = migration.Block
+	}
+	return blocks
+}
+
+// stateMigrationChanges returns encoded state changes of declared state migrations by their names.
+func (c *ChainConfig) stateMigrationChanges() map[string][]byte {
+	changes := make(map[string][]byte, len(c.StateMigrations))
+	for _, migration := range c.StateMigrations {
+		changes[migration.Name], _ = json.Marshal(migration.Accounts)
+	}
+	return changes
+}
+
 // CheckCompatible checks whether scheduled fork transitions have been imported
 // with a mismatching chain configuration.
 func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64, time uint64) *ConfigCompatError {
@@ -846,6 +1018,48 @@ func (c *ChainConfig) CheckConfigForkOrder() error {
 			lastFork = cur
 		}
 	}
+	if c.uniqueBurnTxBlock() != nil {
+		if c.MintContract.ActivationBlock == nil {
+			return errors.New("unique burn tx fork is enabled, but mint contract is not activated")
+		}
+		if c.MintContract.UniqueBurnTxBlock.Cmp(c.MintContract.ActivationBlock) < 0 {
+			return fmt.Errorf("unsupported fork ordering: mint contract activated at block %v, but unique burn tx fork enabled at block %v",
+				c.MintContract.ActivationBlock, c.MintContract.UniqueBurnTxBlock)
+		}
+	}
+	if c.FeeSplit != nil {
+		if err := c.FeeSplit.validate(); err != nil {
+			return err
+		}
+	}
+	if c.cliqueSuspensionBlock() != nil && c.Clique.SuspensionThreshold == 0 {
+		return errors.New("clique suspension threshold is not set")
+	}
+	if c.cliqueRegistryBlock() != nil && (c.SystemContracts == nil || c.SystemContracts.ValidatorRegistry == (common.Address{})) {
+		return errors.New("clique validator registry contract is not set")
+	}
+	return c.checkStateMigrations()
+}
+
+// checkStateMigrations checks that the declared state migrations are scheduled
+// and have unique names, not clashing with the built-in migrations.
+func (c *ChainConfig) checkStateMigrations() error {
+	names := make(map[string]struct{}, len(c.StateMigrations))
+	for i, migration := range c.StateMigrations {
+		if migration.Name == "" {
+			return fmt.Errorf("state migration %d name is not set", i)
+		}
+		if migration.Name == MintContractMigrationName || migration.Name == CassiopeiaMigrationName {
+			return fmt.Errorf("state migration %q clashes with a built-in migration", migration.Name)
+		}
+		if _, exists := names[migration.Name]; exists {
+			return fmt.Errorf("state migration %q is declared more than once", migration.Name)
+		}
+		if migration.Block == nil {
+			return fmt.Errorf("state migration %q block is not set", migration.Name)
+		}
+		names[migration.Name] = struct{}{}
+	}
 	return nil
 }
 
@@ -917,6 +1131,39 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, headNumber *big.Int,
 	if isForkBlockIncompatible(c.CepheusBlock, newcfg.CepheusBlock, headNumber) {
 		return newBlockCompatError("Cepheus fork block", c.CepheusBlock, newcfg.CepheusBlock)
 	}
+	if isForkBlockIncompatible(c.uniqueBurnTxBlock(), newcfg.uniqueBurnTxBlock(), headNumber) {
+		return newBlockCompatError("Unique burn tx fork block", c.uniqueBurnTxBlock(), newcfg.uniqueBurnTxBlock())
+	}
+	if isForkBlockIncompatible(c.cliqueSuspensionBlock(), newcfg.cliqueSuspensionBlock(), headNumber) {
+		return newBlockCompatError("Clique signer suspension fork block", c.cliqueSuspensionBlock(), newcfg.cliqueSuspensionBlock())
+	}
+	if isForkBlockIncompatible(c.cliqueRegistryBlock(), newcfg.cliqueRegistryBlock(), headNumber) {
+		return newBlockCompatError("Clique validator registry fork block", c.cliqueRegistryBlock(), newcfg.cliqueRegistryBlock())
+	}
+	if isForkBlockIncompatible(c.feeSplitBlock(), newcfg.feeSplitBlock(), headNumber) {
+		return newBlockCompatError("Fee split fork block", c.feeSplitBlock(), newcfg.feeSplitBlock())
+	}
+	if isBlockForked(c.feeSplitBlock(), headNumber) && !c.FeeSplit.sameShares(newcfg.FeeSplit) {
+		return newBlockCompatError("Fee split shares", c.feeSplitBlock(), newcfg.feeSplitBlock())
+	}
+	stored, configured := c.stateMigrationBlocks(), newcfg.stateMigrationBlocks()
+	for name, block := range stored {
+		if isForkBlockIncompatible(block, configured[name], headNumber) {
+			return newBlockCompatError(fmt.Sprintf("State migration %q block", name), block, configured[name])
+		}
+	}
+	// Changes of an already applied migration must be left intact as well
+	storedChanges, configuredChanges := c.stateMigrationChanges(), newcfg.stateMigrationChanges()
+	for name, block := range stored {
+		if isBlockForked(block, headNumber) && !bytes.Equal(storedChanges[name], configuredChanges[name]) {
+			return newBlockCompatError(fmt.Sprintf("State migration %q changes", name), block, configured[name])
+		}
+	}
+	for name, block := range configured {
+		if _, exists := stored[name]; !exists && isForkBlockIncompatible(nil, block, headNumber) {
+			return newBlockCompatError(fmt.Sprintf("State migration %q block", name), nil, block)
+		}
+	}
 	return nil
 }
 
@@ -1062,7 +1309,7 @@ type Rules struct {
 	IsByzantium, IsConstantinople, IsPetersburg, IsIstanbul bool
 	IsBerlin, IsLondon                                      bool
 	IsMerge, IsShanghai, IsCancun, IsPrague                 bool
-	IsCepheus                                               bool
+	IsCepheus, IsUniqueBurnTx                               bool
 }
 
 // Rules ensures c's ChainID is not nil.
@@ -1088,5 +1335,6 @@ func (c *ChainConfig) Rules(num *big.Int, isMerge bool, timestamp uint64) Rules
 		IsCancun:         c.IsCancun(timestamp),
 		IsPrague:         c.IsPrague(timestamp),
 		IsCepheus:        c.IsCepheus(num),
+		IsUniqueBurnTx:   c.IsUniqueBurnTx(num),
 	}
 }
@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
 )
 
@@ -109,6 +110,98 @@ func TestCheckCompatible(t *testing.T) {
 				RewindToTime: 9,
 			},
 		},
+		{
+			stored:    &ChainConfig{StateMigrations: []*StateMigrationConfig{{Name: "fix", Block: big.NewInt(30)}}},
+			new:       &ChainConfig{StateMigrations: []*StateMigrationConfig{{Name: "fix", Block: big.NewInt(35)}}},
+			headBlock: 20,
+			wantErr:   nil,
+		},
+		{
+			stored:    &ChainConfig{StateMigrations: []*StateMigrationConfig{{Name: "fix", Block: big.NewInt(30)}}},
+			new:       &ChainConfig{},
+			headBlock: 40,
+			wantErr: &ConfigCompatError{
+				What:          `State migration "fix" block`,
+				StoredBlock:   big.NewInt(30),
+				NewBlock:      nil,
+				RewindToBlock: 29,
+			},
+		},
+		{
+			stored: &ChainConfig{StateMigrations: []*StateMigrationConfig{{Name: "fix", Block: big.NewInt(30), Accounts: map[common.Address]*StateMigrationAccount{
+				{1}: {Storage: map[common.Hash]common.Hash{{1}: {1}}},
+			}}}},
+			new: &ChainConfig{StateMigrations: []*StateMigrationConfig{{Name: "fix", Block: big.NewInt(30), Accounts: map[common.Address]*StateMigrationAccount{
+				{1}: {Storage: map[common.Hash]common.Hash{{1}: {2}}},
+			}}}},
+			headBlock: 20,
+			wantErr:   nil,
+		},
+		{
+			stored: &ChainConfig{StateMigrations: []*StateMigrationConfig{{Name: "fix", Block: big.NewInt(30), Accounts: map[common.Address]*StateMigrationAccount{
+				{1}: {Storage: map[common.Hash]common.Hash{{1}: {1}}},
+			}}}},
+			new: &ChainConfig{StateMigrations: []*StateMigrationConfig{{Name: "fix", Block: big.NewInt(30), Accounts: map[common.Address]*StateMigrationAccount{
+				{1}: {Storage: map[common.Hash]common.Hash{{1}: {2}}},
+			}}}},
+			headBlock: 40,
+			wantErr: &ConfigCompatError{
+				What:          `State migration "fix" changes`,
+				StoredBlock:   big.NewInt(30),
+				NewBlock:      big.NewInt(30),
+				RewindToBlock: 29,
+			},
+		},
+		{
+			stored:    &ChainConfig{FeeSplit: &FeeSplitConfig{Block: big.NewInt(30), Shares: []*FeeShare{{Percent: 60}, {Address: &common.Address{1}, Percent: 40}}}},
+			new:       &ChainConfig{FeeSplit: &FeeSplitConfig{Block: big.NewInt(30), Shares: []*FeeShare{{Percent: 60}, {Address: &common.Address{2}, Percent: 40}}}},
+			headBlock: 20,
+			wantErr:   nil,
+		},
+		{
+			stored:    &ChainConfig{FeeSplit: &FeeSplitConfig{Block: big.NewInt(30), Shares: []*FeeShare{{Percent: 60}, {Address: &common.Address{1}, Percent: 40}}}},
+			new:       &ChainConfig{FeeSplit: &FeeSplitConfig{Block: big.NewInt(30), Shares: []*FeeShare{{Percent: 50}, {Address: &common.Address{1}, Percent: 50}}}},
+			headBlock: 40,
+			wantErr: &ConfigCompatError{
+				What:          "Fee split shares",
+				StoredBlock:   big.NewInt(30),
+				NewBlock:      big.NewInt(30),
+				RewindToBlock: 29,
+			},
+		},
+		{
+			stored:    &ChainConfig{},
+			new:       &ChainConfig{StateMigrations: []*StateMigrationConfig{{Name: "fix", Block: big.NewInt(30)}}},
+			headBlock: 40,
+			wantErr: &ConfigCompatError{
+				What:          `State migration "fix" block`,
+				StoredBlock:   nil,
+				NewBlock:      big.NewInt(30),
+				RewindToBlock: 29,
+			},
+		},
+		{
+			stored:    &ChainConfig{FeeSplit: &FeeSplitConfig{Block: big.NewInt(30)}},
+			new:       &ChainConfig{FeeSplit: &FeeSplitConfig{Block: big.NewInt(50)}},
+			headBlock: 40,
+			wantErr: &ConfigCompatError{
+				What:          "Fee split fork block",
+				StoredBlock:   big.NewInt(30),
+				NewBlock:      big.NewInt(50),
+				RewindToBlock: 29,
+			},
+		},
+		{
+			stored:    &ChainConfig{Clique: &CliqueConfig{SuspensionBlock: big.NewInt(30)}},
+			new:       &ChainConfig{Clique: &CliqueConfig{}},
+			headBlock: 40,
+			wantErr: &ConfigCompatError{
+				What:          "Clique signer suspension fork block",
+				StoredBlock:   big.NewInt(30),
+				NewBlock:      nil,
+				RewindToBlock: 29,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -136,3 +229,81 @@ func TestConfigRules(t *testing.T) {
 		t.Errorf("expected %v to be shanghai", stamp)
 	}
 }
+
+func TestFeeSplitValidation(t *testing.T) {
+	collector := common.Address{1}
+	for _, test := range []struct {
+		split   *FeeSplitConfig
+		wantErr string
+	}{
+		{split: &FeeSplitConfig{Block: big.NewInt(10), Shares: []*FeeShare{{Address: &collector, Percent: 60}, {Percent: 40}}}},
+		{split: &FeeSplitConfig{Shares: []*FeeShare{{Percent: 100}}}, wantErr: "fee split block is not set"},
+		{split: &FeeSplitConfig{Block: big.NewInt(10), Shares: []*FeeShare{{Percent: 100}, {Address: &collector}}}, wantErr: "fee split share 1 is empty"},
+		{split: &FeeSplitConfig{Block: big.NewInt(10), Shares: []*FeeShare{{Address: &collector, Percent: 60}}}, wantErr: "fee split shares sum up to 60 percent, want 100"},
+	} {
+		err := (&ChainConfig{FeeSplit: test.split}).CheckConfigForkOrder()
+		if test.wantErr == "" && err != nil {
+			t.Errorf("unexpected error: %v", err)
+		} else if test.wantErr != "" && (err == nil || err.Error() != test.wantErr) {
+			t.Errorf("error mismatch: have %v, want %v", err, test.wantErr)
+		}
+	}
+}
+
+func TestCliqueSuspensionValidation(t *testing.T) {
+	config := &ChainConfig{Clique: &CliqueConfig{SuspensionBlock: big.NewInt(10)}}
+	if err := config.CheckConfigForkOrder(); err == nil || err.Error() != "clique suspension threshold is not set" {
+		t.Errorf("error mismatch: have %v, want threshold error", err)
+	}
+	config.Clique.SuspensionThreshold = 3
+	if err := config.CheckConfigForkOrder(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCliqueRegistryValidation(t *testing.T) {
+	config := &ChainConfig{Clique: &CliqueConfig{RegistryBlock: big.NewInt(10)}}
+	if err := config.CheckConfigForkOrder(); err == nil || err.Error() != "clique validator registry contract is not set" {
+		t.Errorf("error mismatch: have %v, want registry error", err)
+	}
+	config.SystemContracts = &SystemContracts{ValidatorRegistry: common.Address{1}}
+	if err := config.CheckConfigForkOrder(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestUniqueBurnTxValidation(t *testing.T) {
+	config := &ChainConfig{MintContract: &MintContractConfig{UniqueBurnTxBlock: big.NewInt(10)}}
+	if err := config.CheckConfigForkOrder(); err == nil {
+		t.Error("expected error for unique burn tx fork without mint contract activation")
+	}
+	config.MintContract.ActivationBlock = big.NewInt(20)
+	if err := config.CheckConfigForkOrder(); err == nil {
+		t.Error("expected error for unique burn tx fork before mint contract activation")
+	}
+	config.MintContract.ActivationBlock = big.NewInt(10)
+	if err := config.CheckConfigForkOrder(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestStateMigrationValidation(t *testing.T) {
+	for _, test := range []struct {
+		migrations []*StateMigrationConfig
+		wantErr    string
+	}{
+		{migrations: []*StateMigrationConfig{{Name: "a", Block: big.NewInt(10)}, {Name: "b", Block: big.NewInt(10)}}},
+		{migrations: []*StateMigrationConfig{{Name: "a", Block: big.NewInt(10)}, {Block: big.NewInt(20)}}, wantErr: "state migration 1 name is not set"},
+		{migrations: []*StateMigrationConfig{{Name: "a", Block: big.NewInt(10)}, {Name: "a", Block: big.NewInt(20)}}, wantErr: `state migration "a" is declared more than once`},
+		{migrations: []*StateMigrationConfig{{Name: "a"}}, wantErr: `state migration "a" block is not set`},
+		{migrations: []*StateMigrationConfig{{Name: CassiopeiaMigrationName, Block: big.NewInt(10)}}, wantErr: `state migration "cassiopeia" clashes with a built-in migration`},
+		{migrations: []*StateMigrationConfig{{Name: MintContractMigrationName, Block: big.NewInt(10)}}, wantErr: `state migration "mint contract initialization" clashes with a built-in migration`},
+	} {
+		err := (&ChainConfig{StateMigrations: test.migrations}).CheckConfigForkOrder()
+		if test.wantErr == "" && err != nil {
+			t.Errorf("unexpected error: %v", err)
+		} else if test.wantErr != "" && (err == nil || err.Error() != test.wantErr) {
+			t.Errorf("error mismatch: have %v, want %v", err, test.wantErr)
+		}
+	}
+}
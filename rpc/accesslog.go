@@ -0,0 +1,196 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// accessLogFlushInterval is the interval at which the buffered entries of the
+// access and slow query logs are written out.
+const accessLogFlushInterval = time.Second
+
+// slowLogParamsNamespaces are the namespaces whose call parameters are written
+// into the slow query log. The parameters of the other ones may carry passwords
+// or private keys, only their size is logged.
+var slowLogParamsNamespaces = map[string]bool{
+	"eth":    true,
+	"net":    true,
+	"web3":   true,
+	"debug":  true,
+	"txpool": true,
+}
+
+// AccessLog writes a JSON line for every call served, and another one including
+// the call parameters into the slow query log if the call took longer than the
+// threshold. A single log can be shared by multiple servers. The entries are
+// buffered and written out periodically, until the log is closed.
+type AccessLog struct {
+	access        *bufio.Writer // Destination of the access log, nil if disabled
+	slow          *bufio.Writer // Destination of the slow query log, nil if disabled
+	slowThreshold time.Duration // Minimum duration of the calls in the slow query log
+
+	mu      sync.Mutex    // Serializes the writes of the entries
+	closeCh chan struct{} // Closed to stop the flush loop
+	closed  sync.WaitGroup
+}
+
+// accessLogEntry is a line of the access log or the slow query log.
+type accessLogEntry struct {
+	Time         time.Time       `json:"time"`
+	Transport    string          `json:"transport"`
+	RemoteAddr   string          `json:"remoteAddr,omitempty"`
+	ID           json.RawMessage `json:"id,omitempty"`
+	Method       string          `json:"method"`
+	Subscription string          `json:"subscription,omitempty"` // Name of the subscription created by *_subscribe
+	Batch        int             `json:"batch,omitempty"`        // Number of calls in the batch of the call
+	ParamsSize   int             `json:"paramsSize"`
+	Params       json.RawMessage `json:"params,omitempty"` // Only in the slow query log, for the allowed namespaces
+	DurationMs   float64         `json:"durationMs"`
+	ResponseSize int             `json:"responseSize"`
+	ErrorCode    int             `json:"errorCode,omitempty"`
+	Error        string          `json:"error,omitempty"`
+}
+
+// NewAccessLog creates a log of the served calls. Either writer may be nil to
+// disable the respective log. The log must be closed to write out the buffered
+// entries.
+func NewAccessLog(access, slow io.Writer, slowThreshold time.Duration) *AccessLog {
+	l := &AccessLog{slowThreshold: slowThreshold, closeCh: make(chan struct{})}
+	if access != nil {
+		l.access = bufio.NewWriter(access)
+	}
+	if slow != nil {
+		l.slow = bufio.NewWriter(slow)
+	}
+	l.closed.Add(1)
+	go l.flushLoop()
+	return l
+}
+
+// Close stops the log, writing out the buffered entries.
+func (l *AccessLog) Close() {
+	close(l.closeCh)
+	l.closed.Wait()
+}
+
+// flushLoop periodically writes out the buffered entries.
+func (l *AccessLog) flushLoop() {
+	defer l.closed.Done()
+
+	ticker := time.NewTicker(accessLogFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			l.flush()
+		case <-l.closeCh:
+			l.flush()
+			return
+		}
+	}
+}
+
+// flush writes out the buffered entries of both logs.
+func (l *AccessLog) flush() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, w := range []*bufio.Writer{l.access, l.slow} {
+		if w == nil {
+			continue
+		}
+		if err := w.Flush(); err != nil {
+			log.Debug("Failed to write RPC access log", "err", err)
+		}
+	}
+}
+
+// loggedParams returns whether the parameters of the method may be written into
+// the slow query log.
+func loggedParams(msg *jsonrpcMessage) bool {
+	// Payloads to sign are kept out of the log even in the allowed namespaces
+	elem := strings.SplitN(msg.Method, serviceMethodSeparator, 2)
+	return len(elem) == 2 && slowLogParamsNamespaces[elem[0]] && !strings.HasPrefix(elem[1], "sign")
+}
+
+// record logs a served call. The response is nil for notifications.
+func (l *AccessLog) record(ctx context.Context, msg, resp *jsonrpcMessage, batch int, start time.Time) {
+	if l == nil {
+		return
+	}
+	var (
+		duration = time.Since(start)
+		slow     = l.slow != nil && duration >= l.slowThreshold
+	)
+	if l.access == nil && !slow {
+		return
+	}
+	info := PeerInfoFromContext(ctx)
+	entry := &accessLogEntry{
+		Time:       start,
+		Transport:  info.Transport,
+		RemoteAddr: info.RemoteAddr,
+		ID:         msg.ID,
+		Method:     msg.Method,
+		Batch:      batch,
+		ParamsSize: len(msg.Params),
+		DurationMs: float64(duration) / float64(time.Millisecond),
+	}
+	if msg.isSubscribe() {
+		entry.Subscription, _ = parseSubscriptionName(msg.Params)
+	}
+	if resp != nil {
+		entry.ResponseSize = len(resp.Result)
+		if resp.Error != nil {
+			entry.ErrorCode, entry.Error = resp.Error.Code, resp.Error.Message
+		}
+	}
+	if l.access != nil {
+		l.write(l.access, entry)
+	}
+	if slow {
+		if loggedParams(msg) {
+			entry.Params = msg.Params
+		}
+		l.write(l.slow, entry)
+	}
+}
+
+// write appends an entry to the buffer of the given log.
+func (l *AccessLog) write(w *bufio.Writer, entry *accessLogEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Debug("Failed to encode RPC access log entry", "method", entry.Method, "err", err)
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := w.Write(append(line, '\n')); err != nil {
+		log.Debug("Failed to write RPC access log entry", "method", entry.Method, "err", err)
+	}
+}
@@ -75,9 +75,11 @@ type BatchElem struct {
 
 // Client represents a connection to an RPC server.
 type Client struct {
-	idgen    func() ID // for subscriptions
-	isHTTP   bool      // connection type: http, ws or ipc
-	services *serviceRegistry
+	idgen     func() ID // for subscriptions
+	isHTTP    bool      // connection type: http, ws or ipc
+	services  *serviceRegistry
+	limits    *limiter   // request limits when serving a connection
+	accessLog *AccessLog // log of the calls served over the connection
 
 	idCounter uint32
 
@@ -114,7 +116,7 @@ func (c *Client) newClientConn(conn ServerCodec) *clientConn {
 	ctx := context.Background()
 	ctx = context.WithValue(ctx, clientContextKey{}, c)
 	ctx = context.WithValue(ctx, peerInfoContextKey{}, conn.peerInfo())
-	handler := newHandler(ctx, conn, c.idgen, c.services)
+	handler := newHandler(ctx, conn, c.idgen, c.services, c.limits, c.accessLog)
 	return &clientConn{conn, handler}
 }
 
@@ -226,17 +228,19 @@ func newClient(initctx context.Context, connect reconnectFunc) (*Client, error)
 	if err != nil {
 		return nil, err
 	}
-	c := initClient(conn, randomIDGenerator(), new(serviceRegistry))
+	c := initClient(conn, randomIDGenerator(), new(serviceRegistry), nil, nil)
 	c.reconnectFunc = connect
 	return c, nil
 }
 
-func initClient(conn ServerCodec, idgen func() ID, services *serviceRegistry) *Client {
+func initClient(conn ServerCodec, idgen func() ID, services *serviceRegistry, limits *limiter, accessLog *AccessLog) *Client {
 	_, isHTTP := conn.(*httpConn)
 	c := &Client{
 		isHTTP:      isHTTP,
 		idgen:       idgen,
 		services:    services,
+		limits:      limits,
+		accessLog:   accessLog,
 		writeConn:   conn,
 		close:       make(chan struct{}),
 		closing:     make(chan struct{}),
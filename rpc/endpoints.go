@@ -23,14 +23,17 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 )
 
-// StartIPCEndpoint starts an IPC endpoint.
-func StartIPCEndpoint(ipcEndpoint string, apis []API) (net.Listener, *Server, error) {
+// StartIPCEndpoint starts an IPC endpoint with the given request limits and
+// optional access log.
+func StartIPCEndpoint(ipcEndpoint string, apis []API, limits Limits, accessLog *AccessLog) (net.Listener, *Server, error) {
 	// Register all the APIs exposed by the services.
 	var (
 		handler    = NewServer()
 		regMap     = make(map[string]struct{})
 		registered []string
 	)
+	handler.SetLimits(limits)
+	handler.SetAccessLog(accessLog)
 	for _, api := range apis {
 		if err := handler.RegisterName(api.Namespace, api.Service); err != nil {
 			log.Info("IPC registration failed", "namespace", api.Namespace, "error", err)
@@ -60,6 +60,8 @@ type handler struct {
 	conn           jsonWriter                     // where responses will be sent
 	log            log.Logger
 	allowSubscribe bool
+	limits         *limiter   // request limits of the server, nil for clients
+	accessLog      *AccessLog // log of the calls served, nil if disabled
 
 	subLock    sync.Mutex
 	serverSubs map[ID]*Subscription
@@ -68,9 +70,12 @@ type handler struct {
 type callProc struct {
 	ctx       context.Context
 	notifiers []*Notifier
+	batch     int // number of calls in the batch being processed, zero for single calls
+
+	responseBytes int // size of the results returned so far
 }
 
-func newHandler(connCtx context.Context, conn jsonWriter, idgen func() ID, reg *serviceRegistry) *handler {
+func newHandler(connCtx context.Context, conn jsonWriter, idgen func() ID, reg *serviceRegistry, limits *limiter, accessLog *AccessLog) *handler {
 	rootCtx, cancelRoot := context.WithCancel(connCtx)
 	h := &handler{
 		reg:            reg,
@@ -83,6 +88,8 @@ func newHandler(connCtx context.Context, conn jsonWriter, idgen func() ID, reg *
 		allowSubscribe: true,
 		serverSubs:     make(map[ID]*Subscription),
 		log:            log.Root(),
+		limits:         limits,
+		accessLog:      accessLog,
 	}
 	if conn.remoteAddr() != "" {
 		h.log = h.log.New("conn", conn.remoteAddr())
@@ -134,17 +141,26 @@ func (b *batchCallBuffer) write(ctx context.Context, conn jsonWriter) {
 	b.doWrite(ctx, conn, false)
 }
 
-// timeout sends the responses added so far. For the remaining unanswered call
-// messages, it sends a timeout error response.
-func (b *batchCallBuffer) timeout(ctx context.Context, conn jsonWriter) {
+// respondWithError sends the responses added so far. For the remaining unanswered
+// call messages, it sends the given error response. Before sending, skipped is
+// called for each message which was never processed, excluding the one in
+// progress.
+func (b *batchCallBuffer) respondWithError(ctx context.Context, conn jsonWriter, err error, skipped func(msg, resp *jsonrpcMessage)) {
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
 
-	for _, msg := range b.calls {
+	if b.wrote {
+		return
+	}
+	for i, msg := range b.calls {
+		var resp *jsonrpcMessage
 		if !msg.isNotification() {
-			resp := msg.errorResponse(&internalServerError{errcodeTimeout, errMsgTimeout})
+			resp = msg.errorResponse(err)
 			b.resp = append(b.resp, resp)
 		}
+		if i > 0 {
+			skipped(msg, resp)
+		}
 	}
 	b.doWrite(ctx, conn, true)
 }
@@ -171,6 +187,13 @@ func (h *handler) handleBatch(msgs []*jsonrpcMessage) {
 		})
 		return
 	}
+	// Reject the whole batch if it has too many items
+	if h.limits.batchTooLarge(len(msgs)) {
+		h.startCallProc(func(cp *callProc) {
+			h.respondWithBatchTooLarge(cp, msgs)
+		})
+		return
+	}
 
 	// Handle non-call messages first:
 	calls := make([]*jsonrpcMessage, 0, len(msgs))
@@ -191,15 +214,21 @@ func (h *handler) handleBatch(msgs []*jsonrpcMessage) {
 		)
 
 		cp.ctx, cancel = context.WithCancel(cp.ctx)
+		cp.batch = len(calls)
 		defer cancel()
 
+		// Calls cut off by the timeout or the size limit are logged as answered
+		skipped := func(msg, resp *jsonrpcMessage) {
+			h.accessLog.record(cp.ctx, msg, resp, cp.batch, time.Now())
+		}
+
 		// Cancel the request context after timeout and send an error response. Since the
 		// currently-running method might not return immediately on timeout, we must wait
 		// for the timeout concurrently with processing the request.
 		if timeout, ok := ContextRequestTimeout(cp.ctx); ok {
 			timer = time.AfterFunc(timeout, func() {
 				cancel()
-				callBuffer.timeout(cp.ctx, h.conn)
+				callBuffer.respondWithError(cp.ctx, h.conn, &internalServerError{errcodeTimeout, errMsgTimeout}, skipped)
 			})
 		}
 
@@ -213,6 +242,13 @@ func (h *handler) handleBatch(msgs []*jsonrpcMessage) {
 				break
 			}
 			resp := h.handleCallMsg(cp, msg)
+
+			// Stop processing once the results exceed the size limit, the call
+			// crossing it and the remaining ones are answered with an error.
+			if h.limits.responseTooLarge(cp.responseBytes) {
+				callBuffer.respondWithError(cp.ctx, h.conn, &internalServerError{errcodeResponseTooLarge, errMsgResponseTooLarge}, skipped)
+				break
+			}
 			callBuffer.pushResponse(resp)
 		}
 		if timer != nil {
@@ -226,6 +262,21 @@ func (h *handler) handleBatch(msgs []*jsonrpcMessage) {
 	})
 }
 
+// respondWithBatchTooLarge sends an error response for a batch exceeding the item
+// limit. The protocol has no way to report an error for the entire batch, so the
+// error is attributed to its first call.
+func (h *handler) respondWithBatchTooLarge(cp *callProc, batch []*jsonrpcMessage) {
+	rpcLimitedMeter.Mark(1)
+	resp := errorMessage(&invalidRequestError{errMsgBatchTooLarge})
+	for _, msg := range batch {
+		if msg.isCall() {
+			resp.ID = msg.ID
+			break
+		}
+	}
+	h.conn.writeJSON(cp.ctx, []*jsonrpcMessage{resp}, true)
+}
+
 // handleMsg handles a single message.
 func (h *handler) handleMsg(msg *jsonrpcMessage) {
 	if ok := h.handleImmediate(msg); ok {
@@ -414,10 +465,19 @@ func (h *handler) handleCallMsg(ctx *callProc, msg *jsonrpcMessage) *jsonrpcMess
 	switch {
 	case msg.isNotification():
 		h.handleCall(ctx, msg)
+		h.accessLog.record(ctx.ctx, msg, nil, ctx.batch, start)
 		h.log.Debug("Served "+msg.Method, "duration", time.Since(start))
 		return nil
 	case msg.isCall():
 		resp := h.handleCall(ctx, msg)
+		// Results over the size limit are replaced before the call is logged. In
+		// batches, the limit applies to the combined size of all results.
+		ctx.responseBytes += len(resp.Result)
+		if h.limits.responseTooLarge(ctx.responseBytes) {
+			rpcLimitedMeter.Mark(1)
+			resp = msg.errorResponse(&internalServerError{errcodeResponseTooLarge, errMsgResponseTooLarge})
+		}
+		h.accessLog.record(ctx.ctx, msg, resp, ctx.batch, start)
 		var ctx []interface{}
 		ctx = append(ctx, "reqid", idForLog{msg.ID}, "duration", time.Since(start))
 		if resp.Error != nil {
@@ -439,6 +499,9 @@ func (h *handler) handleCallMsg(ctx *callProc, msg *jsonrpcMessage) *jsonrpcMess
 
 // handleCall processes method calls.
 func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage {
+	if err := h.limits.filterCall(cp.ctx, msg.Method); err != nil {
+		return msg.errorResponse(err)
+	}
 	if msg.isSubscribe() {
 		return h.handleSubscribe(cp, msg)
 	}
@@ -455,6 +518,12 @@ func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage
 	if err != nil {
 		return msg.errorResponse(&invalidParamsError{err.Error()})
 	}
+	release, err := h.limits.acquire(msg.Method)
+	if err != nil {
+		return msg.errorResponse(err)
+	}
+	defer release()
+
 	start := time.Now()
 	answer := h.runMethod(cp.ctx, msg, callb, args)
 	// Collect the statistics for RPC calls if metrics is enabled.
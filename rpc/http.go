@@ -326,6 +326,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	connInfo.HTTP.Host = r.Host
 	connInfo.HTTP.Origin = r.Header.Get("Origin")
 	connInfo.HTTP.UserAgent = r.Header.Get("User-Agent")
+	connInfo.HTTP.APIKey = APIKeyFromRequest(r)
 	ctx := r.Context()
 	ctx = context.WithValue(ctx, peerInfoContextKey{}, connInfo)
 
@@ -365,6 +366,15 @@ func validateRequest(r *http.Request) (int, error) {
 	return http.StatusUnsupportedMediaType, err
 }
 
+// APIKeyFromRequest returns the API key of an HTTP or WebSocket request, sent
+// either in the X-API-Key header or the apikey query parameter.
+func APIKeyFromRequest(r *http.Request) string {
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		return key
+	}
+	return r.URL.Query().Get("apikey")
+}
+
 // ContextRequestTimeout returns the request timeout derived from the given context.
 func ContextRequestTimeout(ctx context.Context) (time.Duration, bool) {
 	timeout := time.Duration(math.MaxInt64)
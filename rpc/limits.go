@@ -0,0 +1,98 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+)
+
+// CallFilter checks a method call before it is executed. The context carries
+// the PeerInfo of the caller.
+type CallFilter func(ctx context.Context, method string) error
+
+// Limits are the request limits of a server. Zero values disable the respective
+// limit.
+//
+// The response size limit caps the bandwidth used by a response. The results are
+// only measured once computed and encoded, so it doesn't bound the memory used
+// while serving a call, which is up to the expensive methods themselves.
+type Limits struct {
+	BatchItems        int            `toml:",omitempty"` // Maximum number of requests in a batch
+	ResponseBytes     int            `toml:",omitempty"` // Maximum size of the encoded results of a response or a whole batch
+	MethodConcurrency map[string]int `toml:",omitempty"` // Maximum number of concurrent calls of a method over all connections
+}
+
+// limiter enforces the limits of a server over all of its connections. A nil
+// limiter doesn't limit anything.
+type limiter struct {
+	Limits
+	slots  map[string]chan struct{} // Concurrent call slots of the limited methods
+	filter CallFilter               // Optional check of every method call
+}
+
+func newLimiter(limits Limits) *limiter {
+	l := &limiter{
+		Limits: limits,
+		slots:  make(map[string]chan struct{}),
+	}
+	for method, limit := range limits.MethodConcurrency {
+		if limit > 0 {
+			l.slots[method] = make(chan struct{}, limit)
+		}
+	}
+	return l
+}
+
+// batchTooLarge returns whether a batch with the given number of items exceeds
+// the limit.
+func (l *limiter) batchTooLarge(items int) bool {
+	return l != nil && l.BatchItems > 0 && items > l.BatchItems
+}
+
+// responseTooLarge returns whether encoded results of the given size exceed the
+// limit.
+func (l *limiter) responseTooLarge(size int) bool {
+	return l != nil && l.ResponseBytes > 0 && size > l.ResponseBytes
+}
+
+// filterCall runs the call filter, if any, on the given method call.
+func (l *limiter) filterCall(ctx context.Context, method string) error {
+	if l == nil || l.filter == nil {
+		return nil
+	}
+	return l.filter(ctx, method)
+}
+
+// acquire reserves a concurrent call slot of the method. It returns an error if
+// all of them are in use, otherwise the function releasing the slot.
+func (l *limiter) acquire(method string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	slots, ok := l.slots[method]
+	if !ok {
+		return func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	default:
+		rpcLimitedMeter.Mark(1)
+		return nil, &internalServerError{errcodeLimitExceeded, fmt.Sprintf("too many concurrent %s requests", method)}
+	}
+}
@@ -32,6 +32,8 @@ var (
 	serveTimeHistName = "rpc/duration"
 
 	rpcServingTimer = metrics.NewRegisteredTimer("rpc/duration/all", nil)
+
+	rpcLimitedMeter = metrics.NewRegisteredMeter("rpc/limited", nil)
 )
 
 // updateServeTimeHistogram tracks the serving time of a remote RPC call.
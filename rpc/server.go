@@ -43,8 +43,10 @@ const (
 
 // Server is an RPC server.
 type Server struct {
-	services serviceRegistry
-	idgen    func() ID
+	services  serviceRegistry
+	idgen     func() ID
+	limits    *limiter
+	accessLog *AccessLog
 
 	mutex  sync.Mutex
 	codecs map[ServerCodec]struct{}
@@ -73,6 +75,32 @@ func (s *Server) RegisterName(name string, receiver interface{}) error {
 	return s.services.registerName(name, receiver)
 }
 
+// SetLimits configures the request limits of the server. It must be called
+// before serving any connection.
+func (s *Server) SetLimits(limits Limits) {
+	l := newLimiter(limits)
+	if s.limits != nil {
+		l.filter = s.limits.filter
+	}
+	s.limits = l
+}
+
+// SetCallFilter installs a function checking every method call before it is
+// executed. If it returns an error, the error is sent to the caller instead.
+// It must be called before serving any connection.
+func (s *Server) SetCallFilter(filter CallFilter) {
+	if s.limits == nil {
+		s.limits = newLimiter(Limits{})
+	}
+	s.limits.filter = filter
+}
+
+// SetAccessLog configures the log of the calls served. It must be called before
+// serving any connection.
+func (s *Server) SetAccessLog(accessLog *AccessLog) {
+	s.accessLog = accessLog
+}
+
 // ServeCodec reads incoming requests from codec, calls the appropriate callback and writes
 // the response back using the given codec. It will block until the codec is closed or the
 // server is stopped. In either case the codec is closed.
@@ -86,7 +114,7 @@ func (s *Server) ServeCodec(codec ServerCodec, options CodecOption) {
 	}
 	defer s.untrackCodec(codec)
 
-	c := initClient(codec, s.idgen, &s.services)
+	c := initClient(codec, s.idgen, &s.services, s.limits, s.accessLog)
 	<-codec.closed()
 	c.Close()
 }
@@ -118,7 +146,7 @@ func (s *Server) serveSingleRequest(ctx context.Context, codec ServerCodec) {
 		return
 	}
 
-	h := newHandler(ctx, codec, s.idgen, &s.services)
+	h := newHandler(ctx, codec, s.idgen, &s.services, s.limits, s.accessLog)
 	h.allowSubscribe = false
 	defer h.close(io.EOF, nil)
 
@@ -191,6 +219,8 @@ type PeerInfo struct {
 		UserAgent string
 		Origin    string
 		Host      string
+		// API key sent by the client, see APIKeyFromRequest.
+		APIKey string
 	}
 }
 
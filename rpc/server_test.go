@@ -19,10 +19,12 @@ package rpc
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"io"
 	"net"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -152,3 +154,169 @@ func TestServerShortLivedConn(t *testing.T) {
 		}
 	}
 }
+
+func TestServerLimits(t *testing.T) {
+	server := newTestServer()
+	server.SetLimits(Limits{
+		BatchItems:        2,
+		ResponseBytes:     50,
+		MethodConcurrency: map[string]int{"test_sleep": 1},
+	})
+	defer server.Stop()
+
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	go server.ServeCodec(NewCodec(serverConn), 0)
+	readbuf := bufio.NewReader(clientConn)
+
+	exchange := func(request string) string {
+		clientConn.SetDeadline(time.Now().Add(5 * time.Second))
+		if _, err := io.WriteString(clientConn, request+"\n"); err != nil {
+			t.Fatalf("write error: %v", err)
+		}
+		resp, err := readbuf.ReadString('\n')
+		if err != nil {
+			t.Fatalf("read error: %v", err)
+		}
+		return strings.TrimRight(resp, "\r\n")
+	}
+	tests := []struct {
+		request, response string
+	}{
+		// Batches over the item limit are rejected as a whole
+		{
+			`[{"jsonrpc":"2.0","id":1,"method":"test_null"},{"jsonrpc":"2.0","id":2,"method":"test_null"},{"jsonrpc":"2.0","id":3,"method":"test_null"}]`,
+			`[{"jsonrpc":"2.0","id":1,"error":{"code":-32600,"message":"batch too large"}}]`,
+		},
+		{
+			`[{"jsonrpc":"2.0","id":1,"method":"test_null"},{"jsonrpc":"2.0","id":2,"method":"test_null"}]`,
+			`[{"jsonrpc":"2.0","id":1,"result":null},{"jsonrpc":"2.0","id":2,"result":null}]`,
+		},
+		// Responses over the size limit are replaced by an error
+		{
+			`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["x",1]}`,
+			`{"jsonrpc":"2.0","id":1,"result":{"String":"x","Int":1,"Args":null}}`,
+		},
+		{
+			`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["xxxxxxxxxxxxxxxxxxxx",1]}`,
+			`{"jsonrpc":"2.0","id":1,"error":{"code":-32003,"message":"response too large"}}`,
+		},
+		// The size limit applies to the sum of the results of a batch
+		{
+			`[{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["x",1]},{"jsonrpc":"2.0","id":2,"method":"test_echo","params":["x",2]}]`,
+			`[{"jsonrpc":"2.0","id":1,"result":{"String":"x","Int":1,"Args":null}},{"jsonrpc":"2.0","id":2,"error":{"code":-32003,"message":"response too large"}}]`,
+		},
+	}
+	for i, test := range tests {
+		if resp := exchange(test.request); resp != test.response {
+			t.Errorf("test %d: wrong response\ngot:  %s\nwant: %s", i, resp, test.response)
+		}
+	}
+	// Concurrent calls of a limited method are rejected over all connections
+	client := DialInProc(server)
+	defer client.Close()
+
+	done := make(chan error)
+	go func() { done <- client.Call(nil, "test_sleep", 500*time.Millisecond) }()
+	time.Sleep(100 * time.Millisecond)
+
+	resp := exchange(`{"jsonrpc":"2.0","id":1,"method":"test_sleep","params":[0]}`)
+	if want := `{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"too many concurrent test_sleep requests"}}`; resp != want {
+		t.Errorf("wrong response\ngot:  %s\nwant: %s", resp, want)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("limited call failed: %v", err)
+	}
+	if err := client.Call(nil, "test_sleep", 0); err != nil {
+		t.Errorf("call failed after release: %v", err)
+	}
+}
+
+func TestServerAccessLog(t *testing.T) {
+	var access, slow bytes.Buffer
+	accessLog := NewAccessLog(&access, &slow, 50*time.Millisecond)
+	server := newTestServer()
+	server.SetAccessLog(accessLog)
+	server.SetLimits(Limits{ResponseBytes: 50})
+	if err := server.RegisterName("debug", new(testService)); err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	go server.ServeCodec(NewCodec(serverConn), 0)
+	readbuf := bufio.NewReader(clientConn)
+
+	for _, request := range []string{
+		`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["x",1]}`,
+		`[{"jsonrpc":"2.0","id":2,"method":"test_null"},{"jsonrpc":"2.0","id":3,"method":"test_returnError"}]`,
+		`{"jsonrpc":"2.0","id":4,"method":"test_sleep","params":[100000000]}`,
+		`[{"jsonrpc":"2.0","id":5,"method":"test_echo","params":["x",1]},{"jsonrpc":"2.0","id":6,"method":"test_echo","params":["x",2]},{"jsonrpc":"2.0","id":7,"method":"test_null"}]`,
+		`{"jsonrpc":"2.0","id":8,"method":"debug_sleep","params":[100000000]}`,
+		`{"jsonrpc":"2.0","id":9,"method":"test_echo","params":["xxxxxxxxxxxxxxxxxxxxxxxxx",1]}`,
+		`{"jsonrpc":"2.0","id":10,"method":"nftest_subscribe","params":["someSubscription",1,1]}`,
+	} {
+		clientConn.SetDeadline(time.Now().Add(5 * time.Second))
+		if _, err := io.WriteString(clientConn, request+"\n"); err != nil {
+			t.Fatalf("write error: %v", err)
+		}
+		if _, err := readbuf.ReadString('\n'); err != nil {
+			t.Fatalf("read error: %v", err)
+		}
+	}
+	// Closing the log writes out the buffered entries
+	accessLog.Close()
+
+	decode := func(buf *bytes.Buffer) []accessLogEntry {
+		var entries []accessLogEntry
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			var entry accessLogEntry
+			if err := json.Unmarshal([]byte(line), &entry); err != nil {
+				t.Fatalf("invalid log line %q: %v", line, err)
+			}
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+	entries := decode(&access)
+	if len(entries) != 10 {
+		t.Fatalf("wrong number of access log entries: got %d, want 10", len(entries))
+	}
+	for i, want := range []accessLogEntry{
+		{Method: "test_echo", ParamsSize: 7, ResponseSize: 34},
+		{Method: "test_null", Batch: 2, ResponseSize: 4},
+		{Method: "test_returnError", Batch: 2, ErrorCode: 444, Error: "testError"},
+		{Method: "test_sleep", ParamsSize: 11, ResponseSize: 4},
+		// Calls of a batch cut off by the size limit are logged with the error
+		{Method: "test_echo", Batch: 3, ParamsSize: 7, ResponseSize: 34},
+		{Method: "test_echo", Batch: 3, ParamsSize: 7, ErrorCode: errcodeResponseTooLarge, Error: errMsgResponseTooLarge},
+		{Method: "test_null", Batch: 3, ErrorCode: errcodeResponseTooLarge, Error: errMsgResponseTooLarge},
+		{Method: "debug_sleep", ParamsSize: 11, ResponseSize: 4},
+		{Method: "test_echo", ParamsSize: 31, ErrorCode: errcodeResponseTooLarge, Error: errMsgResponseTooLarge},
+		{Method: "nftest_subscribe", Subscription: "someSubscription", ParamsSize: 24, ResponseSize: 5},
+	} {
+		have := entries[i]
+		if have.Transport != "ipc" || have.Params != nil {
+			t.Errorf("entry %d: wrong transport %q or params %s", i, have.Transport, have.Params)
+		}
+		have.Time, have.Transport, have.RemoteAddr, have.ID, have.DurationMs = time.Time{}, "", "", nil, 0
+		if !reflect.DeepEqual(have, want) {
+			t.Errorf("entry %d: wrong entry\ngot:  %+v\nwant: %+v", i, have, want)
+		}
+	}
+	// Only the sleeping calls exceed the slow query threshold, the parameters are
+	// logged for the allowed namespaces only
+	slowEntries := decode(&slow)
+	if len(slowEntries) != 2 {
+		t.Fatalf("wrong slow query log: %s", slow.String())
+	}
+	for i, want := range []struct{ method, params string }{{"test_sleep", ""}, {"debug_sleep", "[100000000]"}} {
+		if slowEntries[i].Method != want.method || string(slowEntries[i].Params) != want.params {
+			t.Errorf("slow entry %d: wrong method %s or params %s", i, slowEntries[i].Method, slowEntries[i].Params)
+		}
+		if slowEntries[i].ParamsSize != 11 || slowEntries[i].DurationMs < 100 {
+			t.Errorf("slow entry %d: wrong params size %d or duration %v", i, slowEntries[i].ParamsSize, slowEntries[i].DurationMs)
+		}
+	}
+}
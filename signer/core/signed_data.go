@@ -164,6 +164,26 @@ func (api *SignerAPI) determineSignatureFormat(ctx context.Context, contentType
 		// Clique uses V on the form 0 or 1
 		useEthereumV = false
 		req = &SignDataRequest{ContentType: mediaType, Rawdata: cliqueRlp, Messages: messages, Hash: sighash}
+	case apitypes.ApplicationCliqueAttestation.Mime:
+		// Clique attestations finalize blocks attested by a quorum of signers
+		attestationData, err := fromHex(data)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		number, hash, err := clique.DecodeAttestationData(attestationData)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		messages := []*apitypes.NameValueType{
+			{
+				Name:  "Clique attestation",
+				Typ:   "clique",
+				Value: fmt.Sprintf("clique attestation of block %d [%#x]", number, hash),
+			},
+		}
+		// Clique uses V on the form 0 or 1
+		useEthereumV = false
+		req = &SignDataRequest{ContentType: mediaType, Rawdata: attestationData, Messages: messages, Hash: crypto.Keccak256(attestationData)}
 	case apitypes.DataTyped.Mime:
 		// EIP-712 conformant typed data
 		var err error
@@ -32,6 +32,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/signer/core"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
@@ -245,6 +246,23 @@ func TestSignData(t *testing.T) {
 	} else if have := signature; !bytes.Equal(have, want) {
 		t.Fatalf("want %x, have %x", want, have)
 	}
+	// application/x-clique-attestation
+	attestation, _ := rlp.EncodeToBytes([]interface{}{"clique-attestation", uint64(1), common.Hash{1}})
+	control.approveCh <- "Y"
+	control.inputCh <- "a_long_password"
+	if signature, err = api.SignData(context.Background(), apitypes.ApplicationCliqueAttestation.Mime, a, hexutil.Encode(attestation)); err != nil {
+		t.Fatal(err)
+	}
+	if pubkey, err := crypto.SigToPub(crypto.Keccak256(attestation), signature); err != nil {
+		t.Fatal(err)
+	} else if have := crypto.PubkeyToAddress(*pubkey); have != a.Address() {
+		t.Errorf("wrong attestation signer: have %x, want %x", have, a.Address())
+	}
+	// Other data is refused to be signed as an attestation
+	header, _ := rlp.EncodeToBytes([]interface{}{"clique-header", uint64(1), common.Hash{1}})
+	if _, err = api.SignData(context.Background(), apitypes.ApplicationCliqueAttestation.Mime, a, hexutil.Encode(header)); err == nil {
+		t.Error("expected error signing invalid attestation")
+	}
 }
 
 func TestDomainChainId(t *testing.T) {